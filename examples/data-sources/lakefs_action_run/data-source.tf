@@ -0,0 +1,8 @@
+data "lakefs_action_run" "blocked_merge" {
+  repository = lakefs_repository.example.id
+  run_id     = var.failed_run_id
+}
+
+output "failed_hooks" {
+  value = [for h in data.lakefs_action_run.blocked_merge.hooks : h.hook_id if h.status == "failed"]
+}
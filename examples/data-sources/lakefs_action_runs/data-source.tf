@@ -0,0 +1,9 @@
+data "lakefs_action_runs" "main" {
+  repository = lakefs_repository.example.id
+  branch     = "main"
+  max_items  = 10
+}
+
+output "failed_runs" {
+  value = [for r in data.lakefs_action_runs.main.runs : r.run_id if r.status == "failed"]
+}
@@ -0,0 +1,8 @@
+data "lakefs_branches" "features" {
+  repository = lakefs_repository.example.id
+  prefix     = "feature-"
+}
+
+output "feature_branches" {
+  value = data.lakefs_branches.features.branches[*].id
+}
@@ -0,0 +1,7 @@
+data "lakefs_groups" "teams" {
+  prefix = "team-"
+}
+
+output "team_ids" {
+  value = data.lakefs_groups.teams.groups[*].id
+}
@@ -0,0 +1,10 @@
+data "lakefs_object" "manifest" {
+  repository      = lakefs_repository.example.id
+  ref             = "v1.0.0"
+  path            = "manifest.json"
+  include_content = true
+}
+
+locals {
+  manifest = jsondecode(data.lakefs_object.manifest.content)
+}
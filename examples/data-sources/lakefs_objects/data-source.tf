@@ -0,0 +1,10 @@
+data "lakefs_objects" "partitions" {
+  repository = lakefs_repository.example.id
+  ref        = "main"
+  prefix     = "events/"
+  delimiter  = "/"
+}
+
+output "partitions" {
+  value = [for o in data.lakefs_objects.partitions.objects : o.path if o.path_type == "common_prefix"]
+}
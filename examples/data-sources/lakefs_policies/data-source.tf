@@ -0,0 +1,9 @@
+data "lakefs_policies" "all" {}
+
+# Find every policy that grants a wildcard action
+output "wildcard_policies" {
+  value = [
+    for p in data.lakefs_policies.all.policies : p.id
+    if anytrue([for s in p.statements : contains(s.action, "*") && s.effect == "allow"])
+  ]
+}
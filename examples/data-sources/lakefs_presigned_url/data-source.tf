@@ -0,0 +1,10 @@
+data "lakefs_presigned_url" "report" {
+  repository = lakefs_repository.example.id
+  ref        = "main"
+  path       = "reports/daily.parquet"
+}
+
+output "report_url" {
+  value     = data.lakefs_presigned_url.report.url
+  sensitive = true
+}
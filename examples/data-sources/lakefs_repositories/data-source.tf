@@ -0,0 +1,13 @@
+data "lakefs_repositories" "analytics" {
+  prefix = "analytics-"
+}
+
+resource "lakefs_branch_protection" "main" {
+  for_each = { for repo in data.lakefs_repositories.analytics.repositories : repo.id => repo }
+
+  repository = each.key
+
+  rules = [
+    { pattern = each.value.default_branch }
+  ]
+}
@@ -0,0 +1,8 @@
+data "lakefs_tags" "releases" {
+  repository = lakefs_repository.example.id
+  prefix     = "v"
+}
+
+output "release_commits" {
+  value = { for tag in data.lakefs_tags.releases.tags : tag.id => tag.commit_id }
+}
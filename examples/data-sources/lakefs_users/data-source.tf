@@ -0,0 +1,7 @@
+data "lakefs_users" "service_accounts" {
+  prefix = "svc-"
+}
+
+output "service_account_ids" {
+  value = data.lakefs_users.service_accounts.users[*].id
+}
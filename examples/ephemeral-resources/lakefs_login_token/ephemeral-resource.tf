@@ -0,0 +1,10 @@
+ephemeral "lakefs_login_token" "ci" {}
+
+# Use the token in another provider or a write-only attribute during the same run,
+# for example as a bearer token for an HTTP request against the LakeFS API.
+provider "restapi" {
+  uri = "http://localhost:8000/api/v1"
+  headers = {
+    Authorization = "Bearer ${ephemeral.lakefs_login_token.ci.token}"
+  }
+}
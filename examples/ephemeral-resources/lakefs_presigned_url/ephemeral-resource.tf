@@ -0,0 +1,9 @@
+ephemeral "lakefs_presigned_url" "export" {
+  repository = lakefs_repository.example.id
+  ref        = "v1.0.0"
+  path       = "exports/customers.csv"
+}
+
+resource "example_sftp_transfer" "partner" {
+  source_url_wo = ephemeral.lakefs_presigned_url.export.url
+}
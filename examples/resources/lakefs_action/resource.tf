@@ -0,0 +1,32 @@
+resource "lakefs_action" "pre_merge_checks" {
+  repository = lakefs_repository.example.id
+  branch     = "main"
+  name       = "pre merge checks"
+
+  on = {
+    "pre-merge" = {
+      branches = ["main"]
+    }
+  }
+
+  hooks = [
+    {
+      id   = "format_validator"
+      type = "webhook"
+      properties = {
+        url     = "https://hooks.example.com/validate"
+        timeout = "1m"
+        query_params = jsonencode({
+          prefix = ["tables/"]
+        })
+      }
+    },
+    {
+      id   = "schema_check"
+      type = "lua"
+      properties = {
+        script_path = "scripts/schema_check.lua"
+      }
+    }
+  ]
+}
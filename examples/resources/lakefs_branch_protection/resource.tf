@@ -3,6 +3,6 @@ resource "lakefs_branch_protection" "main" {
 
   rules = [
     { pattern = "main" },
-    { pattern = "release-*" }
+    { pattern = "release-*", blocked_actions = ["staging_write", "delete"] }
   ]
 }
@@ -0,0 +1,9 @@
+resource "lakefs_commit" "seed" {
+  repository = lakefs_repository.example.id
+  branch     = "main"
+  message    = "Seed reference datasets"
+
+  metadata = {
+    source = "terraform"
+  }
+}
@@ -0,0 +1,16 @@
+resource "lakefs_user" "spark" {
+  id = "svc-spark"
+}
+
+resource "lakefs_credentials" "spark" {
+  user = lakefs_user.spark.id
+
+  # Change any value to rotate the access key
+  rotation_trigger = {
+    rotated_on = "2024-01-01"
+  }
+}
+
+output "spark_access_key_id" {
+  value = lakefs_credentials.spark.access_key_id
+}
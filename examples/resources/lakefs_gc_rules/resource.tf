@@ -0,0 +1,9 @@
+resource "lakefs_gc_rules" "example" {
+  repository             = lakefs_repository.example.id
+  default_retention_days = 14
+
+  branches = [
+    { branch = "main", retention_days = 90 },
+    { branch = "dev", retention_days = 3 }
+  ]
+}
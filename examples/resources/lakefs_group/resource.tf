@@ -0,0 +1,4 @@
+resource "lakefs_group" "data_engineers" {
+  id          = "data-engineers"
+  description = "Engineers working on the ingestion pipelines"
+}
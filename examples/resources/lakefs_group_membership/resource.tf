@@ -0,0 +1,7 @@
+resource "lakefs_group_membership" "data_engineers" {
+  group = lakefs_group.data_engineers.id
+  users = [
+    lakefs_user.engineer.id,
+    lakefs_user.analyst.id,
+  ]
+}
@@ -0,0 +1,4 @@
+resource "lakefs_group_policy_attachment" "engineers_read" {
+  group  = lakefs_group.data_engineers.id
+  policy = lakefs_policy.read_example.id
+}
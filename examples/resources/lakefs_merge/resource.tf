@@ -0,0 +1,7 @@
+resource "lakefs_merge" "promote_to_staging" {
+  repository         = lakefs_repository.example.id
+  source_ref         = "dev"
+  destination_branch = "staging"
+  message            = "Promote dev to staging"
+  strategy           = "source-wins"
+}
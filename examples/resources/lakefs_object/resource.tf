@@ -0,0 +1,14 @@
+resource "lakefs_object" "schema" {
+  repository   = lakefs_repository.example.id
+  branch       = "main"
+  path         = "schemas/events.json"
+  source       = "${path.module}/schemas/events.json"
+  content_type = "application/json"
+}
+
+resource "lakefs_object" "readme" {
+  repository = lakefs_repository.example.id
+  branch     = "main"
+  path       = "README.md"
+  content    = "Reference datasets managed by Terraform."
+}
@@ -0,0 +1,16 @@
+resource "lakefs_policy" "read_example" {
+  id = "ExampleRepoRead"
+
+  statements = [
+    {
+      effect   = "allow"
+      action   = ["fs:ReadRepository", "fs:ReadObject", "fs:ListObjects"]
+      resource = "arn:lakefs:fs:::repository/example"
+    },
+    {
+      effect   = "deny"
+      action   = ["fs:DeleteRepository"]
+      resource = "arn:lakefs:fs:::repository/example"
+    }
+  ]
+}
@@ -0,0 +1,5 @@
+resource "lakefs_user" "engineer" {
+  id            = "jane.doe"
+  email         = "jane.doe@example.com"
+  friendly_name = "Jane Doe"
+}
@@ -0,0 +1,4 @@
+resource "lakefs_user_policy_attachment" "engineer_read" {
+  user   = lakefs_user.engineer.id
+  policy = lakefs_policy.read_example.id
+}
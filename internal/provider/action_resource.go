@@ -0,0 +1,819 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// actionsPrefix is the directory LakeFS loads action files from.
+const actionsPrefix = "_lakefs_actions/"
+
+// actionFileNamePattern limits file names to a single path segment that also fits the
+// repository/branch/file_name import ID.
+var actionFileNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// actionFileNameUnsafe matches the characters replaced when deriving a file name from the action name.
+var actionFileNameUnsafe = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
+// actionEvents are the event types a LakeFS action can be triggered by.
+var actionEvents = []string{
+	"pre-commit", "post-commit",
+	"pre-merge", "post-merge",
+	"pre-create-branch", "post-create-branch",
+	"pre-delete-branch", "post-delete-branch",
+	"pre-create-tag", "post-create-tag",
+	"pre-delete-tag", "post-delete-tag",
+	"pre-revert", "post-revert",
+	"pre-cherry-pick", "post-cherry-pick",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ActionResource{}
+var _ resource.ResourceWithImportState = &ActionResource{}
+var _ resource.ResourceWithModifyPlan = &ActionResource{}
+
+var actionTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Update: true,
+	Delete: true,
+}
+
+func NewActionResource() resource.Resource {
+	return &ActionResource{}
+}
+
+// ActionResource defines the resource implementation.
+type ActionResource struct {
+	client *APIClient
+}
+
+// ActionModel describes the resource data model.
+type ActionModel struct {
+	Id            types.String                `tfsdk:"id"`
+	Repository    types.String                `tfsdk:"repository"`
+	Branch        types.String                `tfsdk:"branch"`
+	FileName      types.String                `tfsdk:"file_name"`
+	Path          types.String                `tfsdk:"path"`
+	Name          types.String                `tfsdk:"name"`
+	Description   types.String                `tfsdk:"description"`
+	On            map[string]ActionEventModel `tfsdk:"on"`
+	Hooks         []ActionHookModel           `tfsdk:"hooks"`
+	CommitMessage types.String                `tfsdk:"commit_message"`
+	CommitId      types.String                `tfsdk:"commit_id"`
+	Content       types.String                `tfsdk:"content"`
+	Timeouts      timeouts.Value              `tfsdk:"timeouts"`
+}
+
+// ActionEventModel describes the filters of a single event trigger.
+type ActionEventModel struct {
+	Branches []types.String `tfsdk:"branches"`
+}
+
+// ActionHookModel describes a single hook of the action.
+type ActionHookModel struct {
+	Id          types.String            `tfsdk:"id"`
+	Type        types.String            `tfsdk:"type"`
+	Description types.String            `tfsdk:"description"`
+	Properties  map[string]types.String `tfsdk:"properties"`
+}
+
+// DiffResponse represents an entry of the API response listing the uncommitted changes on a branch
+type DiffResponse struct {
+	Type     string `json:"type"`
+	Path     string `json:"path"`
+	PathType string `json:"path_type"`
+}
+
+// ActionDefinition is the YAML document LakeFS reads from _lakefs_actions/
+type ActionDefinition struct {
+	Name        string                 `yaml:"name"`
+	Description string                 `yaml:"description,omitempty"`
+	On          map[string]ActionEvent `yaml:"on"`
+	Hooks       []ActionHook           `yaml:"hooks"`
+}
+
+// ActionEvent represents the filters of an event in an action definition. Branches is a pointer
+// so that an empty list is written as "branches: []" and read back as empty rather than unset.
+type ActionEvent struct {
+	Branches *[]string `yaml:"branches,omitempty"`
+}
+
+// ActionHook represents a hook in an action definition
+type ActionHook struct {
+	ID          string         `yaml:"id"`
+	Type        string         `yaml:"type"`
+	Description string         `yaml:"description,omitempty"`
+	Properties  map[string]any `yaml:"properties,omitempty"`
+}
+
+func (r *ActionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action"
+}
+
+func (r *ActionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a LakeFS action file under _lakefs_actions/.",
+		MarkdownDescription: `Manages a LakeFS action file under ` + "`_lakefs_actions/`" + `.
+
+The action is rendered to YAML, uploaded to the branch and committed. Changes made to the file outside of Terraform are detected by parsing it again on refresh.
+
+A LakeFS commit includes every uncommitted change on the branch, so creating, updating or deleting the action fails if the branch has uncommitted changes to any other path. Use a branch that is not written to outside of Terraform, or commit those changes first.
+
+Hook properties are strings. Properties that take structured values, such as the ` + "`args`" + ` of a Lua hook or the ` + "`query_params`" + ` of a webhook, can be set with ` + "`jsonencode`" + `.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "lakefs_action" "pre_merge_checks" {
+  repository = lakefs_repository.example.id
+  branch     = "main"
+  name       = "pre merge checks"
+
+  on = {
+    "pre-merge" = {
+      branches = ["main"]
+    }
+  }
+
+  hooks = [
+    {
+      id   = "format_validator"
+      type = "webhook"
+      properties = {
+        url     = "https://hooks.example.com/validate"
+        timeout = "1m"
+        query_params = jsonencode({
+          prefix = ["tables/"]
+        })
+      }
+    },
+    {
+      id   = "schema_check"
+      type = "lua"
+      properties = {
+        script_path = "scripts/schema_check.lua"
+      }
+    }
+  ]
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for this resource, in the format 'repository/branch/file_name'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository to add the action to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Required:    true,
+				Description: "The branch to commit the action file to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the file under _lakefs_actions/. May only contain letters, digits, '_', '.' and '-'. Defaults to the action name with every other character replaced by an underscore and a .yaml extension.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(actionFileNamePattern, "must only contain letters, digits, '_', '.' and '-'"),
+					stringvalidator.NoneOf(".", ".."),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Computed:    true,
+				Description: "The full path of the action file in the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the action.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "A description of the action.",
+			},
+			"on": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "The events that trigger the action, keyed by event type such as 'pre-commit' or 'pre-merge'.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.OneOf(actionEvents...)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"branches": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Branch name patterns the event is limited to. By default the action runs for all branches.",
+						},
+					},
+				},
+			},
+			"hooks": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The hooks to run, in order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:    true,
+							Description: "The identifier of the hook, unique within the action.",
+						},
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The hook type: 'webhook', 'lua' or 'airflow'.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("webhook", "lua", "airflow"),
+							},
+						},
+						"description": schema.StringAttribute{
+							Optional:    true,
+							Description: "A description of the hook.",
+						},
+						"properties": schema.MapAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The hook properties. Values that are JSON objects or arrays are written to the YAML as structured values.",
+						},
+					},
+				},
+			},
+			"commit_message": schema.StringAttribute{
+				Optional:    true,
+				Description: "The message of the commit that adds or updates the action file. Defaults to a message naming the action.",
+			},
+			"commit_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the commit that last wrote the action file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Computed:    true,
+				Description: "The rendered YAML of the action file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, actionTimeouts),
+		},
+	}
+}
+
+// ModifyPlan renders the action at plan time, so content shows the exact YAML to be written and
+// commit_id is only unknown when the file will actually be rewritten.
+func (r *ActionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The YAML depends on values that are only known after apply
+	if !req.Config.Raw.IsFullyKnown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("commit_id"), types.StringUnknown())...)
+		return
+	}
+
+	var plan ActionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rendered, diags := renderAction(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Content = types.StringValue(rendered)
+
+	if !req.State.Raw.IsNull() {
+		var state ActionModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Rewriting the file creates a new commit
+		if !plan.Content.Equal(state.Content) {
+			plan.CommitId = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ActionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
+	if data.FileName.IsUnknown() || data.FileName.IsNull() {
+		data.FileName = types.StringValue(defaultActionFileName(data.Name.ValueString()))
+	}
+	data.Path = types.StringValue(actionsPrefix + data.FileName.ValueString())
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", data.Repository.ValueString(), data.Branch.ValueString(), data.FileName.ValueString()))
+
+	resp.Diagnostics.Append(r.write(ctx, &data, "Add")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ActionModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
+	found, diags := r.read(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ActionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "update", updateTimeout, &resp.Diagnostics)
+
+	rendered, diags := renderAction(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the commit message changed, so there is nothing to write
+	if rendered == state.Content.ValueString() {
+		data.Content = state.Content
+		data.CommitId = state.CommitId
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data, "Update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ActionModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
+
+	repository := data.Repository.ValueString()
+	branch := data.Branch.ValueString()
+
+	tflog.Debug(ctx, "Deleting action", map[string]any{
+		"repository": repository,
+		"branch":     branch,
+		"path":       data.Path.ValueString(),
+	})
+
+	resp.Diagnostics.Append(checkNoOtherChanges(ctx, r.client, repository, branch, data.Path.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{"path": {data.Path.ValueString()}}
+	err := r.client.RequestWithQuery(ctx, http.MethodDelete, fmt.Sprintf("/repositories/%s/branches/%s/objects", repository, branch), query, nil, nil)
+	if err != nil {
+		if IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete action file: %s", err))
+		return
+	}
+
+	commitReq := CommitCreateRequest{Message: fmt.Sprintf("Delete action %s", data.Name.ValueString())}
+	err = r.client.Post(ctx, fmt.Sprintf("/repositories/%s/branches/%s/commits", repository, branch), commitReq, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to commit action file deletion: %s%s", err, actionRunHint(err)))
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted action", map[string]any{"id": data.Id.ValueString()})
+}
+
+func (r *ActionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: repository/branch/file_name
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'repository/branch/file_name', got: %s", req.ID),
+		)
+		return
+	}
+
+	var data ActionModel
+	data.Timeouts = nullTimeouts(ctx, actionTimeouts)
+	data.Id = types.StringValue(req.ID)
+	data.Repository = types.StringValue(parts[0])
+	data.Branch = types.StringValue(parts[1])
+	data.FileName = types.StringValue(parts[2])
+	data.Path = types.StringValue(actionsPrefix + parts[2])
+	data.CommitMessage = types.StringNull()
+
+	found, diags := r.read(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import action %s: no file at %s on branch %s", req.ID, data.Path.ValueString(), parts[1]))
+		return
+	}
+
+	// The last commit that touched the file, so that importing does not plan a rewrite
+	commits := Paginate[CommitResponse](ctx, r.client, fmt.Sprintf("/repositories/%s/refs/%s/commits", parts[0], parts[1]), ListOptions{
+		Query:    url.Values{"objects": {data.Path.ValueString()}, "limit": {"true"}},
+		PageSize: 1,
+		MaxItems: 1,
+	})
+	data.CommitId = types.StringNull()
+	for commit, err := range commits {
+		if err != nil {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to find the commit of action %s: %s", req.ID, err))
+			return
+		}
+		data.CommitId = types.StringValue(commit.ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// read fetches and parses the action file into data, reporting false if the file does not exist.
+func (r *ActionResource) read(ctx context.Context, data *ActionModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	content, err := r.client.GetRaw(ctx, fmt.Sprintf("/repositories/%s/refs/%s/objects", data.Repository.ValueString(), data.Branch.ValueString()), url.Values{"path": {data.Path.ValueString()}})
+	if err != nil {
+		if IsNotFound(err) {
+			return false, diags
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read action file: %s", err))
+		return false, diags
+	}
+
+	var def ActionDefinition
+	if err := yaml.Unmarshal(content, &def); err != nil {
+		diags.AddError(
+			"Invalid Action File",
+			fmt.Sprintf("The action file %s could not be parsed as YAML: %s. Fix or delete the file in LakeFS and refresh again.", data.Path.ValueString(), err),
+		)
+		return false, diags
+	}
+
+	// Map the parsed file back to the model so that edits made outside of Terraform show up as a diff
+	diags.Append(actionDefinitionToModel(def, data)...)
+
+	return true, diags
+}
+
+// write renders the action, uploads it to the branch and commits it.
+func (r *ActionResource) write(ctx context.Context, data *ActionModel, verb string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	repository := data.Repository.ValueString()
+	branch := data.Branch.ValueString()
+	objectPath := data.Path.ValueString()
+
+	rendered, renderDiags := renderAction(*data)
+	diags.Append(renderDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(checkNoOtherChanges(ctx, r.client, repository, branch, objectPath)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Debug(ctx, "Writing action", map[string]any{
+		"repository": repository,
+		"branch":     branch,
+		"path":       objectPath,
+	})
+
+	if _, err := uploadObject(ctx, r.client, repository, branch, objectPath, "application/x-yaml", []byte(rendered)); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to upload action file: %s", err))
+		return diags
+	}
+
+	message := data.CommitMessage.ValueString()
+	if message == "" {
+		message = fmt.Sprintf("%s action %s", verb, data.Name.ValueString())
+	}
+
+	var result CommitResponse
+	err := r.client.Post(ctx, fmt.Sprintf("/repositories/%s/branches/%s/commits", repository, branch), CommitCreateRequest{Message: message}, &result)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to commit action file: %s%s", err, actionRunHint(err)))
+		return diags
+	}
+
+	data.Content = types.StringValue(rendered)
+	data.CommitId = types.StringValue(result.ID)
+
+	tflog.Trace(ctx, "Wrote action", map[string]any{
+		"id":        data.Id.ValueString(),
+		"commit_id": result.ID,
+	})
+
+	return diags
+}
+
+// checkNoOtherChanges fails if branch has uncommitted changes to anything but objectPath. A LakeFS
+// commit includes everything staged on the branch, so committing the action file would otherwise
+// also commit changes that Terraform does not manage.
+func checkNoOtherChanges(ctx context.Context, client *APIClient, repository, branch, objectPath string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A few paths are enough to identify the changes in the error
+	var others []string
+	for change, err := range Paginate[DiffResponse](ctx, client, fmt.Sprintf("/repositories/%s/branches/%s/diff", repository, branch), ListOptions{}) {
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list uncommitted changes on branch %s: %s", branch, err))
+			return diags
+		}
+		if change.Path == objectPath {
+			continue
+		}
+		others = append(others, change.Path)
+		if len(others) == 5 {
+			break
+		}
+	}
+
+	if len(others) > 0 {
+		diags.AddError(
+			"Branch Has Uncommitted Changes",
+			fmt.Sprintf("Branch %q in repository %q has uncommitted changes that are not part of the action file %s, including:\n\n  %s\n\n"+
+				"A LakeFS commit includes every uncommitted change on the branch, so the action file is not committed while they are staged. "+
+				"Commit or reset these changes and apply again.", branch, repository, objectPath, strings.Join(others, "\n  ")),
+		)
+	}
+
+	return diags
+}
+
+// defaultActionFileName derives a file name from the action name, replacing characters such as
+// '/' that would otherwise nest the file or escape _lakefs_actions/
+func defaultActionFileName(name string) string {
+	return actionFileNameUnsafe.ReplaceAllString(name, "_") + ".yaml"
+}
+
+// renderAction converts the model into the YAML document LakeFS expects.
+func renderAction(data ActionModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	def := ActionDefinition{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		On:          map[string]ActionEvent{},
+		Hooks:       []ActionHook{},
+	}
+
+	for event, filter := range data.On {
+		var e ActionEvent
+		if filter.Branches != nil {
+			branches := make([]string, 0, len(filter.Branches))
+			for _, branch := range filter.Branches {
+				branches = append(branches, branch.ValueString())
+			}
+			e.Branches = &branches
+		}
+		def.On[event] = e
+	}
+
+	for _, hook := range data.Hooks {
+		h := ActionHook{
+			ID:          hook.Id.ValueString(),
+			Type:        hook.Type.ValueString(),
+			Description: hook.Description.ValueString(),
+		}
+
+		if len(hook.Properties) > 0 {
+			h.Properties = map[string]any{}
+			for key, value := range hook.Properties {
+				h.Properties[key] = decodeActionProperty(value.ValueString())
+			}
+		}
+
+		def.Hooks = append(def.Hooks, h)
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(def); err != nil {
+		diags.AddError("Unable to Render Action", fmt.Sprintf("Unable to render action %s to YAML: %s", def.Name, err))
+		return "", diags
+	}
+	if err := encoder.Close(); err != nil {
+		diags.AddError("Unable to Render Action", fmt.Sprintf("Unable to render action %s to YAML: %s", def.Name, err))
+		return "", diags
+	}
+
+	return buf.String(), diags
+}
+
+// actionDefinitionToModel maps a parsed action file into the model, re-rendering content from it.
+func actionDefinitionToModel(def ActionDefinition, data *ActionModel) diag.Diagnostics {
+	data.Name = types.StringValue(def.Name)
+	data.Description = optionalString(def.Description)
+
+	data.On = map[string]ActionEventModel{}
+	for event, filter := range def.On {
+		var e ActionEventModel
+		if filter.Branches != nil {
+			e.Branches = make([]types.String, 0, len(*filter.Branches))
+			for _, branch := range *filter.Branches {
+				e.Branches = append(e.Branches, types.StringValue(branch))
+			}
+		}
+		data.On[event] = e
+	}
+
+	data.Hooks = []ActionHookModel{}
+	for _, hook := range def.Hooks {
+		h := ActionHookModel{
+			Id:          types.StringValue(hook.ID),
+			Type:        types.StringValue(hook.Type),
+			Description: optionalString(hook.Description),
+		}
+
+		if len(hook.Properties) > 0 {
+			h.Properties = map[string]types.String{}
+			for key, value := range hook.Properties {
+				h.Properties[key] = types.StringValue(encodeActionProperty(value))
+			}
+		}
+
+		data.Hooks = append(data.Hooks, h)
+	}
+
+	rendered, diags := renderAction(*data)
+	data.Content = types.StringValue(rendered)
+
+	return diags
+}
+
+// decodeActionProperty turns a property string into a structured value when it holds a JSON object or array.
+func decodeActionProperty(value string) any {
+	trimmed := strings.TrimSpace(value)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var decoded any
+		if err := json.Unmarshal([]byte(trimmed), &decoded); err == nil {
+			return decoded
+		}
+	}
+	return value
+}
+
+// encodeActionProperty is the inverse of decodeActionProperty for values parsed from YAML.
+func encodeActionProperty(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]any, []any:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(encoded)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// optionalString maps an empty string to null, for optional attributes that are omitted from the YAML when unset.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
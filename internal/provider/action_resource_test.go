@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// diffHandler serves paths as the uncommitted changes of every branch, and fails the test on
+// any request other than listing them.
+func diffHandler(t *testing.T, paths ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/diff") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		page := Page[DiffResponse]{}
+		for _, p := range paths {
+			page.Results = append(page.Results, DiffResponse{Type: "added", Path: p, PathType: "object"})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(page); err != nil {
+			t.Errorf("failed to encode page: %s", err)
+		}
+	}
+}
+
+func TestCheckNoOtherChanges(t *testing.T) {
+	const actionPath = "_lakefs_actions/checks.yaml"
+
+	tests := map[string]struct {
+		staged  []string
+		wantErr bool
+	}{
+		"clean branch":             {},
+		"only the action file":     {staged: []string{actionPath}},
+		"other uncommitted change": {staged: []string{actionPath, "tables/events/part-0.parquet"}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newTestAPIClient(t, diffHandler(t, tt.staged...))
+
+			diags := checkNoOtherChanges(context.Background(), client, "repo", "main", actionPath)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("expected error %t, got %v", tt.wantErr, diags)
+			}
+			if tt.wantErr && !strings.Contains(diags[0].Detail(), "tables/events/part-0.parquet") {
+				t.Errorf("expected the error to name the staged path, got %q", diags[0].Detail())
+			}
+		})
+	}
+}
+
+func TestActionWriteRefusesOtherChanges(t *testing.T) {
+	// The handler fails the test if the action is uploaded or committed
+	r := &ActionResource{client: newTestAPIClient(t, diffHandler(t, "tables/events/part-0.parquet"))}
+
+	data := ActionModel{
+		Repository: types.StringValue("repo"),
+		Branch:     types.StringValue("main"),
+		Path:       types.StringValue("_lakefs_actions/checks.yaml"),
+		Name:       types.StringValue("checks"),
+		On:         map[string]ActionEventModel{"pre-merge": {}},
+	}
+
+	diags := r.write(context.Background(), &data, "Add")
+	if !diags.HasError() || diags[0].Summary() != "Branch Has Uncommitted Changes" {
+		t.Fatalf("expected a Branch Has Uncommitted Changes error, got %v", diags)
+	}
+	if !data.CommitId.IsNull() {
+		t.Errorf("expected no commit, got %s", data.CommitId)
+	}
+}
+
+func TestRenderAction(t *testing.T) {
+	data := ActionModel{
+		Name: types.StringValue("checks"),
+		On: map[string]ActionEventModel{
+			"pre-merge": {Branches: []types.String{types.StringValue("main")}},
+		},
+		Hooks: []ActionHookModel{
+			{
+				Id:   types.StringValue("validator"),
+				Type: types.StringValue("webhook"),
+				Properties: map[string]types.String{
+					"url":          types.StringValue("https://hooks.example.com/validate"),
+					"query_params": types.StringValue(`{"prefix":["tables/"]}`),
+				},
+			},
+		},
+	}
+
+	want := `name: checks
+"on":
+  pre-merge:
+    branches:
+      - main
+hooks:
+  - id: validator
+    type: webhook
+    properties:
+      query_params:
+        prefix:
+          - tables/
+      url: https://hooks.example.com/validate
+`
+
+	got, diags := renderAction(data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got != want {
+		t.Errorf("renderAction() =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestActionRoundTripsEventBranches(t *testing.T) {
+	tests := map[string]struct {
+		branches []types.String
+		yaml     string
+	}{
+		"unset":    {branches: nil, yaml: "pre-commit: {}"},
+		"empty":    {branches: []types.String{}, yaml: "branches: []"},
+		"patterns": {branches: []types.String{types.StringValue("main"), types.StringValue("release-*")}, yaml: "- release-*"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := ActionModel{
+				Name:  types.StringValue("checks"),
+				On:    map[string]ActionEventModel{"pre-commit": {Branches: tt.branches}},
+				Hooks: []ActionHookModel{{Id: types.StringValue("check"), Type: types.StringValue("lua")}},
+			}
+
+			rendered, diags := renderAction(data)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if !strings.Contains(rendered, tt.yaml) {
+				t.Errorf("expected the YAML to contain %q, got:\n%s", tt.yaml, rendered)
+			}
+
+			var def ActionDefinition
+			if err := yaml.Unmarshal([]byte(rendered), &def); err != nil {
+				t.Fatalf("unexpected error parsing the YAML: %s", err)
+			}
+
+			var read ActionModel
+			diags = actionDefinitionToModel(def, &read)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got := read.On["pre-commit"].Branches; !reflect.DeepEqual(got, tt.branches) {
+				t.Errorf("expected branches %v to read back unchanged, got %v", tt.branches, got)
+			}
+			if read.Content.ValueString() != rendered {
+				t.Errorf("expected the content to render the same, got:\n%s", read.Content.ValueString())
+			}
+		})
+	}
+}
+
+func TestDecodeActionProperty(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		want  any
+	}{
+		"plain string":         {value: "1m", want: "1m"},
+		"number stays string":  {value: "42", want: "42"},
+		"object":               {value: `{"prefix": ["tables/"]}`, want: map[string]any{"prefix": []any{"tables/"}}},
+		"array with spaces":    {value: ` ["a", "b"] `, want: []any{"a", "b"}},
+		"invalid JSON object":  {value: "{not json", want: "{not json"},
+		"braces inside string": {value: "print({})", want: "print({})"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := decodeActionProperty(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodeActionProperty(%q) = %#v, want %#v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeActionProperty(t *testing.T) {
+	tests := map[string]struct {
+		value any
+		want  string
+	}{
+		"string": {value: "1m", want: "1m"},
+		"int":    {value: 42, want: "42"},
+		"bool":   {value: true, want: "true"},
+		"object": {value: map[string]any{"prefix": []any{"tables/"}}, want: `{"prefix":["tables/"]}`},
+		"array":  {value: []any{"a", "b"}, want: `["a","b"]`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := encodeActionProperty(tt.value); got != tt.want {
+				t.Errorf("encodeActionProperty(%#v) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+
+	// Structured values read from YAML decode back to the same value
+	for _, value := range []string{`{"prefix":["tables/"]}`, `["a","b"]`} {
+		if got := encodeActionProperty(decodeActionProperty(value)); got != value {
+			t.Errorf("expected %q to round-trip, got %q", value, got)
+		}
+	}
+}
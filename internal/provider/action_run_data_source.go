@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ActionRunDataSource{}
+
+func NewActionRunDataSource() datasource.DataSource {
+	return &ActionRunDataSource{}
+}
+
+// ActionRunDataSource defines the data source implementation.
+type ActionRunDataSource struct {
+	client *APIClient
+}
+
+// ActionRunModel describes the data source data model.
+type ActionRunModel struct {
+	Repository types.String       `tfsdk:"repository"`
+	RunId      types.String       `tfsdk:"run_id"`
+	Branch     types.String       `tfsdk:"branch"`
+	CommitId   types.String       `tfsdk:"commit_id"`
+	EventType  types.String       `tfsdk:"event_type"`
+	Status     types.String       `tfsdk:"status"`
+	StartTime  types.String       `tfsdk:"start_time"`
+	EndTime    types.String       `tfsdk:"end_time"`
+	Hooks      []HookRunItemModel `tfsdk:"hooks"`
+}
+
+// HookRunItemModel describes the result of a single hook in the run.
+type HookRunItemModel struct {
+	HookRunId types.String `tfsdk:"hook_run_id"`
+	Action    types.String `tfsdk:"action"`
+	HookId    types.String `tfsdk:"hook_id"`
+	Status    types.String `tfsdk:"status"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+}
+
+// ActionRunResponse represents the API response for an action run
+type ActionRunResponse struct {
+	RunID     string `json:"run_id"`
+	Branch    string `json:"branch"`
+	CommitID  string `json:"commit_id"`
+	EventType string `json:"event_type"`
+	Status    string `json:"status"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time,omitempty"`
+}
+
+// HookRunResponse represents the API response for a hook run
+type HookRunResponse struct {
+	HookRunID string `json:"hook_run_id"`
+	Action    string `json:"action"`
+	HookID    string `json:"hook_id"`
+	Status    string `json:"status"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time,omitempty"`
+}
+
+// actionRunHint points to the action run behind a failed commit or merge, if the error names one.
+func actionRunHint(err error) string {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RunID == "" {
+		return ""
+	}
+	return fmt.Sprintf("\n\nThe request was rejected by action run %q. Use the lakefs_action_run data source with this run_id to see which hooks failed.", apiErr.RunID)
+}
+
+func (d *ActionRunDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action_run"
+}
+
+func (d *ActionRunDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a LakeFS action run and the status of each of its hooks.",
+		MarkdownDescription: `Reads a LakeFS action run and the status of each of its hooks.
+
+## Example Usage
+
+` + "```hcl" + `
+data "lakefs_action_run" "blocked_merge" {
+  repository = lakefs_repository.example.id
+  run_id     = var.failed_run_id
+}
+
+output "failed_hooks" {
+  value = [for h in data.lakefs_action_run.blocked_merge.hooks : h.hook_id if h.status == "failed"]
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository the action ran in.",
+			},
+			"run_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the action run.",
+			},
+			"branch": schema.StringAttribute{
+				Computed:    true,
+				Description: "The branch the event occurred on.",
+			},
+			"commit_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The commit ID associated with the event.",
+			},
+			"event_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The event that triggered the run, such as 'pre-merge'.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the run: 'completed' or 'failed'.",
+			},
+			"start_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC 3339 timestamp when the run started.",
+			},
+			"end_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC 3339 timestamp when the run ended.",
+			},
+			"hooks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The hooks executed by the run.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hook_run_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the hook run.",
+						},
+						"action": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the action the hook belongs to.",
+						},
+						"hook_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the hook within the action.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the hook: 'completed', 'failed' or 'skipped'.",
+						},
+						"start_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "RFC 3339 timestamp when the hook started.",
+						},
+						"end_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "RFC 3339 timestamp when the hook ended.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ActionRunDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ActionRunDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ActionRunModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := data.Repository.ValueString()
+	runID := data.RunId.ValueString()
+
+	var result ActionRunResponse
+	err := d.client.Get(ctx, fmt.Sprintf("/repositories/%s/actions/runs/%s", repository, runID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read action run: %s", err))
+		return
+	}
+
+	// Map response to state
+	data.Branch = types.StringValue(result.Branch)
+	data.CommitId = types.StringValue(result.CommitID)
+	data.EventType = types.StringValue(result.EventType)
+	data.Status = types.StringValue(result.Status)
+	data.StartTime = types.StringValue(result.StartTime)
+	data.EndTime = types.StringValue(result.EndTime)
+
+	data.Hooks = []HookRunItemModel{}
+	for hook, err := range Paginate[HookRunResponse](ctx, d.client, fmt.Sprintf("/repositories/%s/actions/runs/%s/hooks", repository, runID), ListOptions{}) {
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list hook runs: %s", err))
+			return
+		}
+
+		data.Hooks = append(data.Hooks, HookRunItemModel{
+			HookRunId: types.StringValue(hook.HookRunID),
+			Action:    types.StringValue(hook.Action),
+			HookId:    types.StringValue(hook.HookID),
+			Status:    types.StringValue(hook.Status),
+			StartTime: types.StringValue(hook.StartTime),
+			EndTime:   types.StringValue(hook.EndTime),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ActionRunsDataSource{}
+
+func NewActionRunsDataSource() datasource.DataSource {
+	return &ActionRunsDataSource{}
+}
+
+// ActionRunsDataSource defines the data source implementation.
+type ActionRunsDataSource struct {
+	client *APIClient
+}
+
+// ActionRunsModel describes the data source data model.
+type ActionRunsModel struct {
+	Repository types.String         `tfsdk:"repository"`
+	Branch     types.String         `tfsdk:"branch"`
+	CommitId   types.String         `tfsdk:"commit_id"`
+	MaxItems   types.Int64          `tfsdk:"max_items"`
+	Runs       []ActionRunItemModel `tfsdk:"runs"`
+}
+
+// ActionRunItemModel describes a single action run in the list.
+type ActionRunItemModel struct {
+	RunId     types.String `tfsdk:"run_id"`
+	Branch    types.String `tfsdk:"branch"`
+	CommitId  types.String `tfsdk:"commit_id"`
+	EventType types.String `tfsdk:"event_type"`
+	Status    types.String `tfsdk:"status"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+}
+
+func (d *ActionRunsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action_runs"
+}
+
+func (d *ActionRunsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the action runs of a LakeFS repository.",
+		MarkdownDescription: `Lists the action runs of a LakeFS repository, most recent first.
+
+## Example Usage
+
+` + "```hcl" + `
+data "lakefs_action_runs" "main" {
+  repository = lakefs_repository.example.id
+  branch     = "main"
+  max_items  = 10
+}
+
+output "failed_runs" {
+  value = [for r in data.lakefs_action_runs.main.runs : r.run_id if r.status == "failed"]
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository to list action runs from.",
+			},
+			"branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return runs triggered on this branch.",
+			},
+			"commit_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return runs associated with this commit.",
+			},
+			"max_items": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Stop after this many runs. By default all pages are fetched.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"runs": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The action runs matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"run_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the action run.",
+						},
+						"branch": schema.StringAttribute{
+							Computed:    true,
+							Description: "The branch the event occurred on.",
+						},
+						"commit_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The commit ID associated with the event.",
+						},
+						"event_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The event that triggered the run, such as 'pre-merge'.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the run: 'completed' or 'failed'.",
+						},
+						"start_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "RFC 3339 timestamp when the run started.",
+						},
+						"end_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "RFC 3339 timestamp when the run ended.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ActionRunsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ActionRunsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ActionRunsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if branch := data.Branch.ValueString(); branch != "" {
+		query.Set("branch", branch)
+	}
+	if commitID := data.CommitId.ValueString(); commitID != "" {
+		query.Set("commit", commitID)
+	}
+
+	opts := ListOptions{
+		Query:    query,
+		MaxItems: int(data.MaxItems.ValueInt64()),
+	}
+	path := fmt.Sprintf("/repositories/%s/actions/runs", data.Repository.ValueString())
+
+	data.Runs = []ActionRunItemModel{}
+	for run, err := range Paginate[ActionRunResponse](ctx, d.client, path, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list action runs: %s", err))
+			return
+		}
+
+		data.Runs = append(data.Runs, ActionRunItemModel{
+			RunId:     types.StringValue(run.RunID),
+			Branch:    types.StringValue(run.Branch),
+			CommitId:  types.StringValue(run.CommitID),
+			EventType: types.StringValue(run.EventType),
+			Status:    types.StringValue(run.Status),
+			StartTime: types.StringValue(run.StartTime),
+			EndTime:   types.StringValue(run.EndTime),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
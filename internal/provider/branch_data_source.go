@@ -22,7 +22,7 @@ func NewBranchDataSource() datasource.DataSource {
 
 // BranchDataSource defines the data source implementation.
 type BranchDataSource struct {
-	client *LakeFSClient
+	client *APIClient
 }
 
 func (d *BranchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,11 +38,11 @@ func (d *BranchDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
+	client, ok := req.ProviderData.(*APIClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -58,13 +58,11 @@ func (d *BranchDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	client := NewAPIClient(d.client)
-
 	repository := data.Repository.ValueString()
 	branch := data.Branch.ValueString()
 
 	var result BranchResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/branches/%s", repository, branch), &result)
+	err := d.client.Get(ctx, fmt.Sprintf("/repositories/%s/branches/%s", repository, branch), &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch: %s", err))
 		return
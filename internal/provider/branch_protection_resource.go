@@ -5,12 +5,18 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,25 +25,47 @@ import (
 var _ resource.Resource = &BranchProtectionResource{}
 var _ resource.ResourceWithImportState = &BranchProtectionResource{}
 
+var branchProtectionTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Update: true,
+	Delete: true,
+}
+
 func NewBranchProtectionResource() resource.Resource {
 	return &BranchProtectionResource{}
 }
 
 // BranchProtectionResource defines the resource implementation.
 type BranchProtectionResource struct {
-	client *LakeFSClient
+	client *APIClient
 }
 
 // BranchProtectionModel describes the resource data model.
 type BranchProtectionModel struct {
-	Repository types.String `tfsdk:"repository"`
-	Id         types.String `tfsdk:"id"`
-	Rules      types.List   `tfsdk:"rules"`
+	Repository types.String   `tfsdk:"repository"`
+	Id         types.String   `tfsdk:"id"`
+	Rules      types.List     `tfsdk:"rules"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 // BranchProtectionRule represents a branch protection rule
 type BranchProtectionRule struct {
 	Pattern string `json:"pattern"`
+	// BlockedActions is omitted when empty so servers that only understand patterns accept the rule
+	BlockedActions []string `json:"blocked_actions,omitempty"`
+}
+
+// branchProtectionActions lists the actions a branch protection rule can block
+var branchProtectionActions = []string{"staging_write", "commit", "delete"}
+
+// branchProtectionETagKey is the private state key holding the ETag of the rules as last read
+const branchProtectionETagKey = "etag"
+
+// branchProtectionRuleAttrTypes describes the object type of a single rule in state
+var branchProtectionRuleAttrTypes = map[string]attr.Type{
+	"pattern":         types.StringType,
+	"blocked_actions": types.SetType{ElemType: types.StringType},
 }
 
 // BranchProtectionRulesResponse represents the API response
@@ -52,7 +80,9 @@ func (r *BranchProtectionResource) Schema(ctx context.Context, req resource.Sche
 		Description: "Manages branch protection rules for a LakeFS repository.",
 		MarkdownDescription: `Manages branch protection rules for a LakeFS repository.
 
-Branch protection rules prevent direct commits to matching branches, requiring changes to be merged via merge operations.
+Branch protection rules prevent direct commits to matching branches, requiring changes to be merged via merge operations. On LakeFS versions that support it, each rule can instead list the specific actions it blocks.
+
+Updates and deletes only succeed if the rules have not changed since Terraform last read them, so concurrent changes from other pipelines or the LakeFS UI are reported instead of overwritten.
 
 ## Example Usage
 
@@ -62,7 +92,7 @@ resource "lakefs_branch_protection" "main" {
 
   rules = [
     { pattern = "main" },
-    { pattern = "release-*" }
+    { pattern = "release-*", blocked_actions = ["staging_write", "delete"] }
   ]
 }
 ` + "```",
@@ -84,10 +114,22 @@ resource "lakefs_branch_protection" "main" {
 							Required:    true,
 							Description: "Pattern to match branch names (supports wildcards, e.g., 'release-*').",
 						},
+						"blocked_actions": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Actions blocked on matching branches: 'staging_write', 'commit' or 'delete'. If not set, the server default applies. Requires a LakeFS version that supports blocked actions; on older servers the apply fails instead of silently dropping them.",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(branchProtectionActions...)),
+							},
+						},
 					},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, branchProtectionTimeouts),
+		},
 	}
 }
 
@@ -96,11 +138,11 @@ func (r *BranchProtectionResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
+	client, ok := req.ProviderData.(*APIClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -116,7 +158,16 @@ func (r *BranchProtectionResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
 	repository := data.Repository.ValueString()
 
 	// Extract rules from the plan
@@ -132,12 +183,15 @@ func (r *BranchProtectionResource) Create(ctx context.Context, req resource.Crea
 	})
 
 	// LakeFS uses PUT to set branch protection rules
-	err := client.Put(ctx, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), rules, nil)
+	header, err := r.client.RequestWithHeaders(ctx, http.MethodPut, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), nil, rules, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create branch protection rules: %s", err))
 		return
 	}
 
+	resp.Diagnostics.Append(setBranchProtectionETag(ctx, resp.Private, header.Get("ETag"))...)
+	resp.Diagnostics.Append(r.checkBlockedActionsStored(ctx, repository, rules, &data)...)
+
 	// Set computed fields
 	data.Id = types.StringValue(repository)
 
@@ -154,11 +208,20 @@ func (r *BranchProtectionResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
 	repository := data.Repository.ValueString()
 
 	var result BranchProtectionRulesResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), &result)
+	header, err := r.client.RequestWithHeaders(ctx, http.MethodGet, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), nil, nil, &result)
 	if err != nil {
 		if IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -178,6 +241,7 @@ func (r *BranchProtectionResource) Read(ctx context.Context, req resource.ReadRe
 	data.Rules = rulesList
 	data.Id = types.StringValue(repository)
 
+	resp.Diagnostics.Append(setBranchProtectionETag(ctx, resp.Private, header.Get("ETag"))...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -189,7 +253,16 @@ func (r *BranchProtectionResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "update", updateTimeout, &resp.Diagnostics)
+
 	repository := data.Repository.ValueString()
 
 	// Extract rules from the plan
@@ -199,17 +272,31 @@ func (r *BranchProtectionResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	etag, diags := getBranchProtectionETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Updating branch protection rules", map[string]any{
 		"repository": repository,
 		"rules":      rules,
+		"etag":       etag,
 	})
 
-	err := client.Put(ctx, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), rules, nil)
+	header, err := r.client.RequestWithHeaders(ctx, http.MethodPut, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), ifMatch(etag), rules, nil)
 	if err != nil {
+		if IsPreconditionFailed(err) {
+			resp.Diagnostics.AddError("Branch Protection Rules Changed", branchProtectionChangedDetail(repository))
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update branch protection rules: %s", err))
 		return
 	}
 
+	resp.Diagnostics.Append(setBranchProtectionETag(ctx, resp.Private, header.Get("ETag"))...)
+	resp.Diagnostics.Append(r.checkBlockedActionsStored(ctx, repository, rules, &data)...)
+
 	data.Id = types.StringValue(repository)
 
 	tflog.Trace(ctx, "Updated branch protection rules", map[string]any{"repository": repository})
@@ -225,14 +312,33 @@ func (r *BranchProtectionResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
+
 	repository := data.Repository.ValueString()
 
+	etag, diags := getBranchProtectionETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting branch protection rules", map[string]any{"repository": repository})
 
 	// Delete by setting empty rules
-	err := client.Put(ctx, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), []BranchProtectionRule{}, nil)
+	_, err := r.client.RequestWithHeaders(ctx, http.MethodPut, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), ifMatch(etag), []BranchProtectionRule{}, nil)
 	if err != nil {
+		if IsPreconditionFailed(err) {
+			resp.Diagnostics.AddError("Branch Protection Rules Changed", branchProtectionChangedDetail(repository))
+			return
+		}
 		if !IsNotFound(err) {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete branch protection rules: %s", err))
 			return
@@ -243,11 +349,10 @@ func (r *BranchProtectionResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *BranchProtectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	client := NewAPIClient(r.client)
 	repository := req.ID
 
 	var result BranchProtectionRulesResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), &result)
+	header, err := r.client.RequestWithHeaders(ctx, http.MethodGet, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), nil, nil, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import branch protection rules for %s: %s", repository, err))
 		return
@@ -260,13 +365,117 @@ func (r *BranchProtectionResource) ImportState(ctx context.Context, req resource
 	}
 
 	var data BranchProtectionModel
+	data.Timeouts = nullTimeouts(ctx, branchProtectionTimeouts)
 	data.Id = types.StringValue(repository)
 	data.Repository = types.StringValue(repository)
 	data.Rules = rulesList
 
+	resp.Diagnostics.Append(setBranchProtectionETag(ctx, resp.Private, header.Get("ETag"))...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// privateStateGetter is satisfied by the private state of resource requests
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateStateSetter is satisfied by the private state of resource responses
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getBranchProtectionETag returns the ETag stored by the last read, or "" if the server did not send one
+func getBranchProtectionETag(ctx context.Context, private privateStateGetter) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value, d := private.GetKey(ctx, branchProtectionETagKey)
+	diags.Append(d...)
+	if len(value) == 0 {
+		return "", diags
+	}
+
+	var etag string
+	if err := json.Unmarshal(value, &etag); err != nil {
+		diags.AddError("Invalid Private State", fmt.Sprintf("Unable to decode stored branch protection ETag: %s", err))
+	}
+	return etag, diags
+}
+
+// setBranchProtectionETag stores etag in private state, removing the key when the server sent none
+func setBranchProtectionETag(ctx context.Context, private privateStateSetter, etag string) diag.Diagnostics {
+	if etag == "" {
+		return private.SetKey(ctx, branchProtectionETagKey, nil)
+	}
+
+	value, err := json.Marshal(etag)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Invalid Private State", fmt.Sprintf("Unable to encode branch protection ETag: %s", err))
+		return diags
+	}
+	return private.SetKey(ctx, branchProtectionETagKey, value)
+}
+
+// ifMatch returns the conditional headers for etag, or none if the ETag is unknown
+func ifMatch(etag string) http.Header {
+	if etag == "" {
+		return nil
+	}
+	return http.Header{"If-Match": {etag}}
+}
+
+// checkBlockedActionsStored reads the rules back after a write that sets blocked_actions. LakeFS
+// versions without blocked action support accept the field but drop it, which would otherwise
+// leave a diff that no apply can resolve. If it was dropped, data.Rules is set to the stored rules.
+func (r *BranchProtectionResource) checkBlockedActionsStored(ctx context.Context, repository string, rules []BranchProtectionRule, data *BranchProtectionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var pattern string
+	for _, rule := range rules {
+		if len(rule.BlockedActions) > 0 {
+			pattern = rule.Pattern
+			break
+		}
+	}
+	if pattern == "" {
+		return diags
+	}
+
+	var stored BranchProtectionRulesResponse
+	if err := r.client.Get(ctx, fmt.Sprintf("/repositories/%s/settings/branch_protection", repository), &stored); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read back branch protection rules: %s", err))
+		return diags
+	}
+
+	for _, rule := range stored {
+		if len(rule.BlockedActions) > 0 {
+			return diags
+		}
+	}
+
+	diags.AddError(
+		"Blocked Actions Not Supported",
+		fmt.Sprintf("The branch protection rules of repository %q were saved without blocked_actions: "+
+			"blocked actions are not supported by this LakeFS server, which ignored them for pattern %q. "+
+			"Remove blocked_actions from the rules, or upgrade LakeFS to a version that supports them.", repository, pattern),
+	)
+
+	rulesList, d := branchProtectionRulesToTerraformList(ctx, stored)
+	diags.Append(d...)
+	if !d.HasError() {
+		data.Rules = rulesList
+	}
+
+	return diags
+}
+
+// branchProtectionChangedDetail explains a 412 response to a conditional branch protection update
+func branchProtectionChangedDetail(repository string) string {
+	return fmt.Sprintf("The branch protection rules of repository %q were changed since this plan was created, "+
+		"by another Terraform run, pipeline or the LakeFS UI. No changes were made. "+
+		"Run terraform plan again to review the current rules before applying.", repository)
+}
+
 // extractBranchProtectionRules extracts rules from Terraform types
 func extractBranchProtectionRules(ctx context.Context, rulesList types.List) ([]BranchProtectionRule, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -285,6 +494,9 @@ func extractBranchProtectionRules(ctx context.Context, rulesList types.List) ([]
 		rule := BranchProtectionRule{
 			Pattern: attrs["pattern"].(types.String).ValueString(),
 		}
+		if blocked, ok := attrs["blocked_actions"].(types.Set); ok && !blocked.IsNull() && !blocked.IsUnknown() {
+			diags.Append(blocked.ElementsAs(ctx, &rule.BlockedActions, false)...)
+		}
 		rules = append(rules, rule)
 	}
 
@@ -295,30 +507,35 @@ func extractBranchProtectionRules(ctx context.Context, rulesList types.List) ([]
 func branchProtectionRulesToTerraformList(ctx context.Context, rules []BranchProtectionRule) (types.List, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	ruleAttrTypes := map[string]attr.Type{
-		"pattern": types.StringType,
-	}
-
 	if len(rules) == 0 {
 		return types.ListValueMust(
-			types.ObjectType{AttrTypes: ruleAttrTypes},
+			types.ObjectType{AttrTypes: branchProtectionRuleAttrTypes},
 			[]attr.Value{},
 		), diags
 	}
 
 	var ruleValues []attr.Value
 	for _, rule := range rules {
+		// Servers without blocked action support omit the field, which maps to null
+		blocked := types.SetNull(types.StringType)
+		if len(rule.BlockedActions) > 0 {
+			var d diag.Diagnostics
+			blocked, d = types.SetValueFrom(ctx, types.StringType, rule.BlockedActions)
+			diags.Append(d...)
+		}
+
 		ruleObj, _ := types.ObjectValue(
-			ruleAttrTypes,
+			branchProtectionRuleAttrTypes,
 			map[string]attr.Value{
-				"pattern": types.StringValue(rule.Pattern),
+				"pattern":         types.StringValue(rule.Pattern),
+				"blocked_actions": blocked,
 			},
 		)
 		ruleValues = append(ruleValues, ruleObj)
 	}
 
 	rulesList, _ := types.ListValue(
-		types.ObjectType{AttrTypes: ruleAttrTypes},
+		types.ObjectType{AttrTypes: branchProtectionRuleAttrTypes},
 		ruleValues,
 	)
 
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckBlockedActionsStored(t *testing.T) {
+	written := []BranchProtectionRule{
+		{Pattern: "main"},
+		{Pattern: "release-*", BlockedActions: []string{"staging_write", "delete"}},
+	}
+
+	tests := map[string]struct {
+		rules   []BranchProtectionRule
+		stored  []BranchProtectionRule
+		wantErr bool
+		reads   int
+	}{
+		"stored by the server": {
+			rules:  written,
+			stored: written,
+			reads:  1,
+		},
+		"dropped by the server": {
+			rules:   written,
+			stored:  []BranchProtectionRule{{Pattern: "main"}, {Pattern: "release-*"}},
+			wantErr: true,
+			reads:   1,
+		},
+		"no blocked actions to check": {
+			rules: []BranchProtectionRule{{Pattern: "main"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			reads := 0
+			r := &BranchProtectionResource{client: newTestAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+				reads++
+				if r.Method != http.MethodGet {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(tt.stored); err != nil {
+					t.Errorf("failed to encode rules: %s", err)
+				}
+			})}
+
+			planned, diags := branchProtectionRulesToTerraformList(context.Background(), tt.rules)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			data := BranchProtectionModel{Repository: types.StringValue("repo"), Rules: planned}
+
+			diags = r.checkBlockedActionsStored(context.Background(), "repo", tt.rules, &data)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("expected error %t, got %v", tt.wantErr, diags)
+			}
+			if reads != tt.reads {
+				t.Errorf("expected %d reads, got %d", tt.reads, reads)
+			}
+
+			// A rejected write records the rules the server actually kept
+			want := planned
+			if tt.wantErr {
+				want, _ = branchProtectionRulesToTerraformList(context.Background(), tt.stored)
+				if diags[0].Summary() != "Blocked Actions Not Supported" {
+					t.Errorf("unexpected error summary %q", diags[0].Summary())
+				}
+			}
+			if !data.Rules.Equal(want) {
+				t.Errorf("expected rules %s, got %s", want, data.Rules)
+			}
+		})
+	}
+}
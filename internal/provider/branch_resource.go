@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -19,13 +21,26 @@ import (
 var _ resource.Resource = &BranchResource{}
 var _ resource.ResourceWithImportState = &BranchResource{}
 
+// branchTimeouts has no update timeout, since branches cannot be changed in place.
+var branchTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Delete: true,
+}
+
 func NewBranchResource() resource.Resource {
 	return &BranchResource{}
 }
 
 // BranchResource defines the resource implementation.
 type BranchResource struct {
-	client *LakeFSClient
+	client *APIClient
+}
+
+// branchResourceModel adds the timeouts block to the generated branch model.
+type branchResourceModel struct {
+	resource_branch.BranchModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // BranchCreateRequest represents the request to create a branch
@@ -46,6 +61,9 @@ func (r *BranchResource) Metadata(ctx context.Context, req resource.MetadataRequ
 
 func (r *BranchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_branch.BranchResourceSchema(ctx)
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": timeouts.Block(ctx, branchTimeouts),
+	}
 }
 
 func (r *BranchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,11 +71,11 @@ func (r *BranchResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
+	client, ok := req.ProviderData.(*APIClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -66,14 +84,22 @@ func (r *BranchResource) Configure(ctx context.Context, req resource.ConfigureRe
 }
 
 func (r *BranchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_branch.BranchModel
+	var data branchResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
 
 	repository := data.Repository.ValueString()
 	createReq := BranchCreateRequest{
@@ -88,8 +114,12 @@ func (r *BranchResource) Create(ctx context.Context, req resource.CreateRequest,
 	})
 
 	// LakeFS branch creation returns a plain string (the commit ID), not JSON
-	commitID, err := client.PostRaw(ctx, fmt.Sprintf("/repositories/%s/branches", repository), createReq)
+	commitID, err := r.client.PostRaw(ctx, fmt.Sprintf("/repositories/%s/branches", repository), createReq)
 	if err != nil {
+		if IsConflict(err) {
+			resp.Diagnostics.AddError("Branch Already Exists", alreadyExistsDetail("branch", createReq.Name, "lakefs_branch", fmt.Sprintf("%s/%s", repository, createReq.Name), err))
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create branch: %s", err))
 		return
 	}
@@ -108,14 +138,22 @@ func (r *BranchResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_branch.BranchModel
+	var data branchResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
 
 	repository := data.Repository.ValueString()
 	branchName := data.Name.ValueString()
@@ -124,7 +162,7 @@ func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	var result BranchResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/branches/%s", repository, branchName), &result)
+	err := r.client.Get(ctx, fmt.Sprintf("/repositories/%s/branches/%s", repository, branchName), &result)
 	if err != nil {
 		if IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -141,26 +179,49 @@ func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *BranchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_branch.BranchModel
+	var data, state branchResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Branches in LakeFS cannot be updated - name changes require delete/recreate
+	// Computed values are planned as unknown on any in-place change, such as to timeouts, so keep them from state
+	if data.Branch.IsUnknown() {
+		data.Branch = state.Branch
+	}
+	if data.CommitId.IsUnknown() {
+		data.CommitId = state.CommitId
+	}
+	if data.Id.IsUnknown() {
+		data.Id = state.Id
+	}
+	if data.Repository.IsUnknown() {
+		data.Repository = state.Repository
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *BranchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_branch.BranchModel
+	var data branchResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
 
 	repository := data.Repository.ValueString()
 	branchName := data.Name.ValueString()
@@ -173,7 +234,7 @@ func (r *BranchResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		"branch":     branchName,
 	})
 
-	err := client.Delete(ctx, fmt.Sprintf("/repositories/%s/branches/%s", repository, branchName))
+	err := r.client.Delete(ctx, fmt.Sprintf("/repositories/%s/branches/%s", repository, branchName))
 	if err != nil {
 		if !IsNotFound(err) {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete branch: %s", err))
@@ -198,18 +259,18 @@ func (r *BranchResource) ImportState(ctx context.Context, req resource.ImportSta
 		return
 	}
 
-	client := NewAPIClient(r.client)
 	repository := parts[0]
 	branchName := parts[1]
 
 	var result BranchResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/branches/%s", repository, branchName), &result)
+	err := r.client.Get(ctx, fmt.Sprintf("/repositories/%s/branches/%s", repository, branchName), &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import branch %s: %s", req.ID, err))
 		return
 	}
 
-	var data resource_branch.BranchModel
+	var data branchResourceModel
+	data.Timeouts = nullTimeouts(ctx, branchTimeouts)
 	data.Id = types.StringValue(req.ID)
 	data.Repository = types.StringValue(repository)
 	data.Name = types.StringValue(branchName)
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BranchesDataSource{}
+
+func NewBranchesDataSource() datasource.DataSource {
+	return &BranchesDataSource{}
+}
+
+// BranchesDataSource defines the data source implementation.
+type BranchesDataSource struct {
+	client *APIClient
+}
+
+// BranchesModel describes the data source data model.
+type BranchesModel struct {
+	Repository types.String      `tfsdk:"repository"`
+	Prefix     types.String      `tfsdk:"prefix"`
+	MaxItems   types.Int64       `tfsdk:"max_items"`
+	Branches   []BranchItemModel `tfsdk:"branches"`
+}
+
+// BranchItemModel describes a single branch in the list.
+type BranchItemModel struct {
+	Id       types.String `tfsdk:"id"`
+	CommitId types.String `tfsdk:"commit_id"`
+}
+
+func (d *BranchesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branches"
+}
+
+func (d *BranchesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the branches of a LakeFS repository.",
+		MarkdownDescription: `Lists the branches of a LakeFS repository.
+
+## Example Usage
+
+` + "```hcl" + `
+data "lakefs_branches" "features" {
+  repository = lakefs_repository.example.id
+  prefix     = "feature-"
+}
+
+output "feature_branches" {
+  value = data.lakefs_branches.features.branches[*].id
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository to list branches from.",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return branches whose name starts with this prefix.",
+			},
+			"max_items": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Stop after this many branches. By default all pages are fetched.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"branches": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The branches matching the filter, in lexicographical order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the branch.",
+						},
+						"commit_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The commit ID the branch points to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BranchesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BranchesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BranchesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if prefix := data.Prefix.ValueString(); prefix != "" {
+		query.Set("prefix", prefix)
+	}
+
+	opts := ListOptions{
+		Query:    query,
+		MaxItems: int(data.MaxItems.ValueInt64()),
+	}
+	path := fmt.Sprintf("/repositories/%s/branches", data.Repository.ValueString())
+
+	data.Branches = []BranchItemModel{}
+	for branch, err := range Paginate[BranchResponse](ctx, d.client, path, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list branches: %s", err))
+			return
+		}
+
+		data.Branches = append(data.Branches, BranchItemModel{
+			Id:       types.StringValue(branch.ID),
+			CommitId: types.StringValue(branch.CommitID),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
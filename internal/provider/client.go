@@ -8,100 +8,367 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"iter"
+	"math/rand/v2"
+	"net"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// APIClient is a client for the LakeFS API
+const (
+	// defaultRetryMinWait is the backoff before the first retry; it doubles on every attempt
+	defaultRetryMinWait = 500 * time.Millisecond
+	// defaultRetryMaxWait caps the backoff between retries when the provider does not set one
+	defaultRetryMaxWait = 30 * time.Second
+	// defaultRequestTimeout bounds a single request attempt when the provider does not set request_timeout
+	defaultRequestTimeout = 30 * time.Second
+	// defaultMaxIdleConnsPerHost keeps enough idle connections for Terraform's default parallelism of 10
+	defaultMaxIdleConnsPerHost = 10
+)
+
+// APIClient is a client for the LakeFS API.
+// The provider builds a single APIClient in Configure and shares it between all resources and
+// data sources, so connections are reused across operations. It is safe for concurrent use;
+// its fields must not be modified once requests have started.
 type APIClient struct {
 	BaseURL    string
 	HTTPClient *http.Client
 	Username   string
 	Password   string
+
+	// RequestTimeout bounds each request attempt, including reading the response. Zero means no limit.
+	RequestTimeout time.Duration
+
+	// MaxRetries is the number of times a failed request is retried. Zero disables retries.
+	MaxRetries int
+	// RetryMinWait is the base backoff, doubled on each attempt and jittered.
+	RetryMinWait time.Duration
+	// RetryMaxWait caps the backoff and any Retry-After value sent by the server.
+	RetryMaxWait time.Duration
+
+	// limiter enforces max_concurrent_requests and requests_per_second across all callers.
+	limiter *requestLimiter
 }
 
 // NewAPIClient creates a new LakeFS API client
 func NewAPIClient(config *LakeFSClient) *APIClient {
-	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: config.SkipSSLVerify,
-		},
+	retryMaxWait := config.RetryMaxWait
+	if retryMaxWait <= 0 {
+		retryMaxWait = defaultRetryMaxWait
 	}
 
+	requestTimeout := config.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+
+	maxIdleConnsPerHost := config.MaxIdleConnsPerHost
+	if maxIdleConnsPerHost <= 0 {
+		maxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	}
+
+	// Start from the default transport to keep its proxy, dial and idle timeout settings
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: config.SkipSSLVerify,
+	}
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	transport.MaxIdleConns = max(transport.MaxIdleConns, maxIdleConnsPerHost)
+	transport.MaxConnsPerHost = config.MaxConnsPerHost
+
 	return &APIClient{
 		BaseURL: strings.TrimSuffix(config.Endpoint, "/"),
+		// Timeouts are applied through the request context so they compose with resource timeouts
 		HTTPClient: &http.Client{
-			Timeout:   time.Second * 30,
 			Transport: transport,
 		},
-		Username: config.AccessKeyID,
-		Password: config.SecretAccessKey,
+		Username:       config.AccessKeyID,
+		Password:       config.SecretAccessKey,
+		RequestTimeout: requestTimeout,
+		MaxRetries:     config.MaxRetries,
+		RetryMinWait:   defaultRetryMinWait,
+		RetryMaxWait:   retryMaxWait,
+		limiter:        newRequestLimiter(config.MaxConcurrentRequests, config.RequestsPerSecond),
 	}
 }
 
 // Request performs an HTTP request to the LakeFS API
 func (c *APIClient) Request(ctx context.Context, method, path string, body interface{}, result interface{}) error {
-	var bodyReader io.Reader
+	return c.RequestWithQuery(ctx, method, path, nil, body, result)
+}
 
-	if body != nil {
-		jsonBody, err := json.Marshal(body)
-		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
+// RequestWithQuery performs an HTTP request to the LakeFS API with the given query parameters
+func (c *APIClient) RequestWithQuery(ctx context.Context, method, path string, query url.Values, body interface{}, result interface{}) error {
+	jsonBody, err := marshalBody(body)
+	if err != nil {
+		return err
+	}
+
+	return c.RequestWithBody(ctx, method, path, query, "application/json", jsonBody, result)
+}
+
+// RequestWithBody performs an HTTP request to the LakeFS API, sending body as-is with the given content type.
+// The response is still decoded as JSON into result. body is not copied, so it must not change until the call returns.
+func (c *APIClient) RequestWithBody(ctx context.Context, method, path string, query url.Values, contentType string, body []byte, result interface{}) error {
+	respBody, _, err := c.do(ctx, method, path, query, nil, contentType, "application/json", body)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(ctx, respBody, result)
+}
+
+// RequestWithHeaders performs a JSON request with additional request headers, such as If-Match,
+// and returns the response headers alongside the decoded result.
+func (c *APIClient) RequestWithHeaders(ctx context.Context, method, path string, header http.Header, body interface{}, result interface{}) (http.Header, error) {
+	jsonBody, err := marshalBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, respHeader, err := c.do(ctx, method, path, nil, header, "application/json", "application/json", jsonBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respHeader, decodeResponse(ctx, respBody, result)
+}
+
+// marshalBody encodes a JSON request body, returning nil when there is no body
+func marshalBody(body interface{}) ([]byte, error) {
+	if body == nil {
+		return nil, nil
+	}
+
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+	return jsonBody, nil
+}
+
+// decodeResponse logs a JSON response body and decodes it into result, if any
+func decodeResponse(ctx context.Context, respBody []byte, result interface{}) error {
+	tflog.Debug(ctx, "API response body", map[string]any{
+		"body": string(respBody),
+	})
+
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
 		}
-		bodyReader = bytes.NewReader(jsonBody)
 	}
 
+	return nil
+}
+
+// GetRaw performs a GET request and returns the raw response body.
+// This is useful for endpoints that return object data instead of JSON.
+func (c *APIClient) GetRaw(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	respBody, _, err := c.do(ctx, http.MethodGet, path, query, nil, "", "*/*", nil)
+	return respBody, err
+}
+
+// do sends a request and returns the response body and headers, converting non-2xx responses into an APIError.
+// Headers in header are added to the request after the defaults, so callers can set conditional headers.
+// Failed attempts are retried according to MaxRetries; see shouldRetry for which failures qualify.
+// Every attempt reads body from the start through its own reader, so retries never copy it.
+func (c *APIClient) do(ctx context.Context, method, path string, query url.Values, header http.Header, contentType, accept string, body []byte) ([]byte, http.Header, error) {
 	url := c.BaseURL + path
-	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if len(query) > 0 {
+		url += "?" + query.Encode()
+	}
+
+	for attempt := 0; ; attempt++ {
+		tflog.Debug(ctx, "Making API request", map[string]any{
+			"method":  method,
+			"url":     url,
+			"attempt": attempt + 1,
+		})
+
+		respBody, respHeader, err := c.doAttempt(ctx, method, url, header, contentType, accept, body)
+		if err == nil {
+			return respBody, respHeader, nil
+		}
+
+		if attempt >= c.MaxRetries || !shouldRetry(ctx, method, err) {
+			return nil, respHeader, err
+		}
+
+		wait := c.retryWait(attempt, respHeader)
+		tflog.Debug(ctx, "Retrying API request", map[string]any{
+			"method":  method,
+			"url":     url,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+			"error":   err.Error(),
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, respHeader, err
+		case <-timer.C:
+		}
+	}
+}
+
+// doAttempt sends the request once, after waiting for the request limiter. The attempt,
+// but not the wait before it, is bounded by RequestTimeout.
+func (c *APIClient) doAttempt(ctx context.Context, method, url string, header http.Header, contentType, accept string, payload []byte) ([]byte, http.Header, error) {
+	release, err := c.limiter.acquire(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, err
+	}
+	defer release()
+
+	attemptCtx := ctx
+	if c.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		attemptCtx, cancel = context.WithTimeout(ctx, c.RequestTimeout)
+		defer cancel()
+	}
+
+	// A fresh reader per attempt, rather than rewinding a shared one, is safe even if the
+	// transport is still reading the previous attempt's body after it gave up
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(attemptCtx, method, url, body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.SetBasicAuth(c.Username, c.Password)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", accept)
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
 
-	tflog.Debug(ctx, "Making API request", map[string]any{
-		"method": method,
-		"url":    url,
-	})
+	respBody, respHeader, err := c.send(ctx, req)
+	// Name request_timeout when it, rather than the operation's own deadline, cut the attempt short
+	if err != nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		err = fmt.Errorf("request timed out after %s (request_timeout): %w", c.RequestTimeout, err)
+	}
+	return respBody, respHeader, err
+}
 
+// send performs a single HTTP round trip, converting non-2xx responses into an APIError
+func (c *APIClient) send(ctx context.Context, req *http.Request) ([]byte, http.Header, error) {
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return nil, nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	tflog.Debug(ctx, "API response", map[string]any{
 		"status": resp.StatusCode,
-		"body":   string(respBody),
+		"size":   len(respBody),
 	})
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		var apiErr APIError
-		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Message != "" {
-			return &apiErr
-		}
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+		return nil, resp.Header, newAPIError(req, resp, respBody)
 	}
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
+	return respBody, resp.Header, nil
+}
+
+// shouldRetry reports whether a failed request can safely be sent again.
+// Idempotent methods are retried on transient statuses and on any transport error.
+// Other methods are only retried when the server cannot have acted on the request:
+// a 429, or a transport error raised before the connection was established.
+func shouldRetry(ctx context.Context, method string, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, errRateLimitPastDeadline) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.Code {
+		case http.StatusTooManyRequests:
+			return true
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return isIdempotent(method)
 		}
+		return false
 	}
 
-	return nil
+	return isIdempotent(method) || neverReachedServer(err)
+}
+
+// isIdempotent reports whether repeating a request with method has the same effect as sending it once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// neverReachedServer reports whether a transport error happened while resolving or dialing,
+// before any part of the request was written
+func neverReachedServer(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// retryWait returns how long to wait before retrying after attempt, honoring Retry-After
+// when the server sent one and otherwise using jittered exponential backoff
+func (c *APIClient) retryWait(attempt int, header http.Header) time.Duration {
+	if wait, ok := parseRetryAfter(header.Get("Retry-After")); ok {
+		return min(wait, c.RetryMaxWait)
+	}
+
+	backoff := c.RetryMinWait << attempt
+	if backoff <= 0 || backoff > c.RetryMaxWait {
+		backoff = c.RetryMaxWait
+	}
+	if backoff <= 1 {
+		return backoff
+	}
+
+	// Equal jitter: wait at least half the backoff so retries still slow down
+	half := backoff / 2
+	return half + rand.N(backoff-half)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
 }
 
 // Get performs a GET request
@@ -109,6 +376,11 @@ func (c *APIClient) Get(ctx context.Context, path string, result interface{}) er
 	return c.Request(ctx, http.MethodGet, path, nil, result)
 }
 
+// GetWithQuery performs a GET request with the given query parameters
+func (c *APIClient) GetWithQuery(ctx context.Context, path string, query url.Values, result interface{}) error {
+	return c.RequestWithQuery(ctx, http.MethodGet, path, query, nil, result)
+}
+
 // Post performs a POST request
 func (c *APIClient) Post(ctx context.Context, path string, body interface{}, result interface{}) error {
 	return c.Request(ctx, http.MethodPost, path, body, result)
@@ -117,43 +389,14 @@ func (c *APIClient) Post(ctx context.Context, path string, body interface{}, res
 // PostRaw performs a POST request and returns the raw response body as a string
 // This is useful for APIs that return plain text instead of JSON
 func (c *APIClient) PostRaw(ctx context.Context, path string, body interface{}) (string, error) {
-	var bodyReader io.Reader
-
-	if body != nil {
-		jsonBody, err := json.Marshal(body)
-		if err != nil {
-			return "", fmt.Errorf("failed to marshal request body: %w", err)
-		}
-		bodyReader = bytes.NewReader(jsonBody)
-	}
-
-	url := c.BaseURL + path
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bodyReader)
-	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.SetBasicAuth(c.Username, c.Password)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
+	jsonBody, err := marshalBody(body)
 	if err != nil {
-		return "", fmt.Errorf("failed to execute request: %w", err)
+		return "", err
 	}
-	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	respBody, _, err := c.do(ctx, http.MethodPost, path, nil, nil, "application/json", "application/json", jsonBody)
 	if err != nil {
-		return "", fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		var apiErr APIError
-		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Message != "" {
-			return "", &apiErr
-		}
-		return "", fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+		return "", err
 	}
 
 	return string(respBody), nil
@@ -169,26 +412,184 @@ func (c *APIClient) Delete(ctx context.Context, path string) error {
 	return c.Request(ctx, http.MethodDelete, path, nil, nil)
 }
 
-// APIError represents an error from the LakeFS API
+// Pagination represents the pagination envelope returned by LakeFS list endpoints
+type Pagination struct {
+	HasMore    bool   `json:"has_more"`
+	NextOffset string `json:"next_offset"`
+	Results    int    `json:"results"`
+	MaxPerPage int    `json:"max_per_page"`
+}
+
+// Page represents a single page returned by a LakeFS list endpoint
+type Page[T any] struct {
+	Pagination Pagination `json:"pagination"`
+	Results    []T        `json:"results"`
+}
+
+// ListOptions configures how Paginate walks a LakeFS list endpoint
+type ListOptions struct {
+	// Query holds extra query parameters, such as prefix or delimiter.
+	Query url.Values
+	// PageSize is the number of results requested per page. Zero uses the server default.
+	PageSize int
+	// MaxItems caps the total number of results returned. Zero means no limit.
+	MaxItems int
+}
+
+// Paginate returns an iterator over the results of a LakeFS list endpoint.
+// Pages are fetched lazily, following next_offset until has_more is false,
+// the MaxItems cap is reached, or ctx is cancelled. Any error is yielded once
+// and ends the iteration.
+func Paginate[T any](ctx context.Context, c *APIClient, path string, opts ListOptions) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		query := url.Values{}
+		for k, v := range opts.Query {
+			query[k] = v
+		}
+		if opts.PageSize > 0 {
+			query.Set("amount", fmt.Sprintf("%d", opts.PageSize))
+		}
+
+		count := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			var page Page[T]
+			if err := c.GetWithQuery(ctx, path, query, &page); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, result := range page.Results {
+				if opts.MaxItems > 0 && count >= opts.MaxItems {
+					return
+				}
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(result, nil) {
+					return
+				}
+				count++
+			}
+
+			if opts.MaxItems > 0 && count >= opts.MaxItems {
+				return
+			}
+			if !page.Pagination.HasMore || page.Pagination.NextOffset == "" {
+				return
+			}
+			query.Set("after", page.Pagination.NextOffset)
+		}
+	}
+}
+
+// ListAll collects every result of a LakeFS list endpoint into a slice
+func ListAll[T any](ctx context.Context, c *APIClient, path string, opts ListOptions) ([]T, error) {
+	results := []T{}
+	for result, err := range Paginate[T](ctx, c, path, opts) {
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// APIError represents a non-2xx response from the LakeFS API. The status, method, path and
+// request ID are always taken from the HTTP exchange; only the message and run ID come from the body.
 type APIError struct {
 	Message string `json:"message"`
-	Code    int    `json:"status_code,omitempty"`
+	RunID   string `json:"run_id,omitempty"`
+
+	Code      int    `json:"-"`
+	Method    string `json:"-"`
+	Path      string `json:"-"`
+	RequestID string `json:"-"`
+}
+
+// requestIDHeader is the response header LakeFS uses to identify a request in its logs
+const requestIDHeader = "X-Request-Id"
+
+// hookRunIDPattern matches the action run ID LakeFS embeds in errors from failed hooks
+var hookRunIDPattern = regexp.MustCompile(`run id '([^']+)'`)
+
+// newAPIError builds an APIError from a failed response, falling back to the
+// status text when the body is not a LakeFS error document
+func newAPIError(req *http.Request, resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{}
+	if err := json.Unmarshal(body, apiErr); err != nil || apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(body))
+		if apiErr.Message == "" {
+			apiErr.Message = http.StatusText(resp.StatusCode)
+		}
+	}
+	apiErr.Code = resp.StatusCode
+	apiErr.Method = req.Method
+	apiErr.Path = req.URL.Path
+	apiErr.RequestID = resp.Header.Get(requestIDHeader)
+	if apiErr.RunID == "" {
+		if match := hookRunIDPattern.FindStringSubmatch(apiErr.Message); match != nil {
+			apiErr.RunID = match[1]
+		}
+	}
+	return apiErr
 }
 
 func (e *APIError) Error() string {
-	if e.Code != 0 {
-		return fmt.Sprintf("LakeFS API error (status %d): %s", e.Code, e.Message)
+	msg := fmt.Sprintf("LakeFS API error (status %d", e.Code)
+	if e.Method != "" {
+		msg += fmt.Sprintf(", %s %s", e.Method, e.Path)
 	}
-	return fmt.Sprintf("LakeFS API error: %s", e.Message)
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(", request ID %s", e.RequestID)
+	}
+	return msg + "): " + e.Message
+}
+
+// hasStatus reports whether err, or any error it wraps, is an APIError with the given status
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Code == status
 }
 
 // IsNotFound returns true if the error is a 404 Not Found error
 func IsNotFound(err error) bool {
-	if apiErr, ok := err.(*APIError); ok {
-		return apiErr.Code == 404
-	}
-	if err != nil {
-		return strings.Contains(err.Error(), "status 404")
-	}
-	return false
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsForbidden returns true if the error is a 403 Forbidden error,
+// as returned when the provider's credentials lack a permission
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsConflict returns true if the error is a 409 Conflict error
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsPreconditionFailed returns true if the error is a 412 Precondition Failed error,
+// as returned when an If-Match header no longer matches the server's ETag
+func IsPreconditionFailed(err error) bool {
+	return hasStatus(err, http.StatusPreconditionFailed)
+}
+
+// IsLocked returns true if the error is a 423 Locked error,
+// as returned when a resource is held by another operation
+func IsLocked(err error) bool {
+	return hasStatus(err, http.StatusLocked)
+}
+
+// alreadyExistsDetail explains a 409 from a create request and how to import the existing object instead
+func alreadyExistsDetail(kind, name, resourceType, importID string, err error) string {
+	return fmt.Sprintf("A %s named %q already exists. To manage it with Terraform, import it instead of creating it:\n\n"+
+		"  terraform import %s.<name> %s\n\n"+
+		"LakeFS reported: %s", kind, name, resourceType, importID, err)
 }
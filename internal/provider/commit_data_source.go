@@ -23,7 +23,7 @@ func NewCommitDataSource() datasource.DataSource {
 
 // CommitDataSource defines the data source implementation.
 type CommitDataSource struct {
-	client *LakeFSClient
+	client *APIClient
 }
 
 // CommitResponse represents the API response for a commit
@@ -52,11 +52,11 @@ func (d *CommitDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
+	client, ok := req.ProviderData.(*APIClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -72,13 +72,11 @@ func (d *CommitDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	client := NewAPIClient(d.client)
-
 	repository := data.Repository.ValueString()
 	commitID := data.CommitId.ValueString()
 
 	var result CommitResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/commits/%s", repository, commitID), &result)
+	err := d.client.Get(ctx, fmt.Sprintf("/repositories/%s/commits/%s", repository, commitID), &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read commit: %s", err))
 		return
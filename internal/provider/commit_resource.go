@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CommitResource{}
+
+// commitTimeouts has no update timeout, since commits are immutable.
+var commitTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Delete: true,
+}
+
+func NewCommitResource() resource.Resource {
+	return &CommitResource{}
+}
+
+// CommitResource defines the resource implementation.
+type CommitResource struct {
+	client *APIClient
+}
+
+// CommitResourceModel describes the resource data model.
+type CommitResourceModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Repository   types.String   `tfsdk:"repository"`
+	Branch       types.String   `tfsdk:"branch"`
+	Message      types.String   `tfsdk:"message"`
+	Metadata     types.Map      `tfsdk:"metadata"`
+	Date         types.Int64    `tfsdk:"date"`
+	AllowEmpty   types.Bool     `tfsdk:"allow_empty"`
+	CommitId     types.String   `tfsdk:"commit_id"`
+	Committer    types.String   `tfsdk:"committer"`
+	CreationDate types.Int64    `tfsdk:"creation_date"`
+	Parents      types.List     `tfsdk:"parents"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// CommitCreateRequest represents the request to commit staged changes on a branch
+type CommitCreateRequest struct {
+	Message    string            `json:"message"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	Date       *int64            `json:"date,omitempty"`
+	AllowEmpty bool              `json:"allow_empty,omitempty"`
+}
+
+func (r *CommitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_commit"
+}
+
+func (r *CommitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Commits the staged changes on a LakeFS branch.",
+		MarkdownDescription: `Commits the staged changes on a LakeFS branch.
+
+Commits are immutable, so changing any argument creates a new commit. Destroying this resource only removes it from the Terraform state; the commit remains in the branch history.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "lakefs_commit" "seed" {
+  repository = lakefs_repository.example.id
+  branch     = "main"
+  message    = "Seed reference datasets"
+
+  metadata = {
+    source = "terraform"
+  }
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for this resource, in the format 'repository/commit_id'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository containing the branch.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Required:    true,
+				Description: "The branch to commit on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Required:    true,
+				Description: "The commit message.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Key/value metadata to attach to the commit.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"date": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Unix epoch timestamp to record as the commit date instead of the current time.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"allow_empty": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Create the commit even if the branch has no staged changes. Default is false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"commit_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the created commit.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"committer": schema.StringAttribute{
+				Computed:    true,
+				Description: "The user that created the commit.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_date": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Unix epoch timestamp of the commit.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"parents": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The parent commit IDs.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, commitTimeouts),
+		},
+	}
+}
+
+func (r *CommitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CommitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CommitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
+	repository := data.Repository.ValueString()
+	branch := data.Branch.ValueString()
+
+	createReq := CommitCreateRequest{
+		Message:    data.Message.ValueString(),
+		AllowEmpty: data.AllowEmpty.ValueBool(),
+	}
+
+	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+		resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &createReq.Metadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !data.Date.IsNull() && !data.Date.IsUnknown() {
+		date := data.Date.ValueInt64()
+		createReq.Date = &date
+	}
+
+	tflog.Debug(ctx, "Creating commit", map[string]any{
+		"repository": repository,
+		"branch":     branch,
+	})
+
+	var result CommitResponse
+	err := r.client.Post(ctx, fmt.Sprintf("/repositories/%s/branches/%s/commits", repository, branch), createReq, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create commit: %s%s", err, actionRunHint(err)))
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", repository, result.ID))
+	resp.Diagnostics.Append(mapCommitResponse(ctx, &data, result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created commit", map[string]any{
+		"repository": repository,
+		"commit_id":  result.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CommitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CommitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
+	var result CommitResponse
+	err := r.client.Get(ctx, fmt.Sprintf("/repositories/%s/commits/%s", data.Repository.ValueString(), data.CommitId.ValueString()), &result)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read commit: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(mapCommitResponse(ctx, &data, result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CommitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CommitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Commits are immutable - every argument forces replacement
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CommitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CommitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Commits cannot be removed from a branch's history, so the commit is left in place
+	tflog.Debug(ctx, "Removing commit from state without changing the branch", map[string]any{
+		"repository": data.Repository.ValueString(),
+		"commit_id":  data.CommitId.ValueString(),
+	})
+}
+
+// mapCommitResponse copies the server-computed commit fields into the resource model.
+// The message, metadata and date arguments are left as configured.
+func mapCommitResponse(ctx context.Context, data *CommitResourceModel, result CommitResponse) diag.Diagnostics {
+	data.CommitId = types.StringValue(result.ID)
+	data.Committer = types.StringValue(result.Committer)
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	parents, diags := types.ListValueFrom(ctx, types.StringType, result.Parents)
+	data.Parents = parents
+
+	return diags
+}
@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CredentialsResource{}
+var _ resource.ResourceWithImportState = &CredentialsResource{}
+
+// credentialsTimeouts has no update timeout, since access keys are rotated by replacement.
+var credentialsTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Delete: true,
+}
+
+func NewCredentialsResource() resource.Resource {
+	return &CredentialsResource{}
+}
+
+// CredentialsResource defines the resource implementation.
+type CredentialsResource struct {
+	client *APIClient
+}
+
+// CredentialsModel describes the resource data model.
+type CredentialsModel struct {
+	Id              types.String   `tfsdk:"id"`
+	User            types.String   `tfsdk:"user"`
+	RotationTrigger types.Map      `tfsdk:"rotation_trigger"`
+	AccessKeyID     types.String   `tfsdk:"access_key_id"`
+	SecretAccessKey types.String   `tfsdk:"secret_access_key"`
+	CreationDate    types.Int64    `tfsdk:"creation_date"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// CredentialsResponse represents the API response for an access key
+type CredentialsResponse struct {
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key,omitempty"`
+	CreationDate    int64  `json:"creation_date"`
+}
+
+func (r *CredentialsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credentials"
+}
+
+func (r *CredentialsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues an access key for a LakeFS user.",
+		MarkdownDescription: `Issues an access key for a LakeFS user.
+
+The secret access key is only returned by LakeFS when the key is created and is stored in the Terraform state. Change ` + "`rotation_trigger`" + ` to issue a new key and revoke the old one.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "lakefs_credentials" "spark" {
+  user = lakefs_user.spark.id
+
+  rotation_trigger = {
+    rotated_on = "2024-01-01"
+  }
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for this resource, in the format 'user/access_key_id'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Required:    true,
+				Description: "The user ID to issue the access key for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_trigger": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values that, when changed, replaces the access key.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The access key ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret access key. Only available for keys created by Terraform.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_date": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Unix epoch timestamp when the access key was created.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, credentialsTimeouts),
+		},
+	}
+}
+
+func (r *CredentialsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CredentialsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
+	user := data.User.ValueString()
+
+	tflog.Debug(ctx, "Creating credentials", map[string]any{"user": user})
+
+	var result CredentialsResponse
+	err := r.client.Post(ctx, fmt.Sprintf("/auth/users/%s/credentials", user), nil, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create credentials: %s", err))
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", user, result.AccessKeyID))
+	data.AccessKeyID = types.StringValue(result.AccessKeyID)
+	data.SecretAccessKey = types.StringValue(result.SecretAccessKey)
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	tflog.Trace(ctx, "Created credentials", map[string]any{
+		"user":          user,
+		"access_key_id": result.AccessKeyID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CredentialsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
+	user := data.User.ValueString()
+	accessKeyID := data.AccessKeyID.ValueString()
+
+	var result CredentialsResponse
+	err := r.client.Get(ctx, fmt.Sprintf("/auth/users/%s/credentials/%s", user, accessKeyID), &result)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credentials: %s", err))
+		return
+	}
+
+	// The secret is never returned after creation, so it is kept from state
+	data.AccessKeyID = types.StringValue(result.AccessKeyID)
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CredentialsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Access keys are immutable - rotation is handled by replacement
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CredentialsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
+
+	user := data.User.ValueString()
+	accessKeyID := data.AccessKeyID.ValueString()
+
+	tflog.Debug(ctx, "Deleting credentials", map[string]any{
+		"user":          user,
+		"access_key_id": accessKeyID,
+	})
+
+	err := r.client.Delete(ctx, fmt.Sprintf("/auth/users/%s/credentials/%s", user, accessKeyID))
+	if err != nil {
+		if !IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete credentials: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted credentials", map[string]any{
+		"user":          user,
+		"access_key_id": accessKeyID,
+	})
+}
+
+func (r *CredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: user/access_key_id
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'user/access_key_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	user := parts[0]
+	accessKeyID := parts[1]
+
+	var result CredentialsResponse
+	err := r.client.Get(ctx, fmt.Sprintf("/auth/users/%s/credentials/%s", user, accessKeyID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import credentials %s: %s", req.ID, err))
+		return
+	}
+
+	var data CredentialsModel
+	data.Timeouts = nullTimeouts(ctx, credentialsTimeouts)
+	data.Id = types.StringValue(req.ID)
+	data.User = types.StringValue(user)
+	data.RotationTrigger = types.MapNull(types.StringType)
+	data.AccessKeyID = types.StringValue(result.AccessKeyID)
+	data.SecretAccessKey = types.StringNull() // The secret cannot be retrieved after creation
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
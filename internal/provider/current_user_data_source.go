@@ -21,7 +21,7 @@ func NewCurrentUserDataSource() datasource.DataSource {
 
 // CurrentUserDataSource defines the data source implementation.
 type CurrentUserDataSource struct {
-	client *LakeFSClient
+	client *APIClient
 }
 
 // CurrentUserModel describes the data source data model.
@@ -86,11 +86,11 @@ func (d *CurrentUserDataSource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
+	client, ok := req.ProviderData.(*APIClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -106,10 +106,8 @@ func (d *CurrentUserDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	client := NewAPIClient(d.client)
-
 	var result CurrentUserResponse
-	err := client.Get(ctx, "/user", &result)
+	err := d.client.Get(ctx, "/user", &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read current user: %s", err))
 		return
@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GCRulesResource{}
+var _ resource.ResourceWithImportState = &GCRulesResource{}
+
+var gcRulesTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Update: true,
+	Delete: true,
+}
+
+func NewGCRulesResource() resource.Resource {
+	return &GCRulesResource{}
+}
+
+// GCRulesResource defines the resource implementation.
+type GCRulesResource struct {
+	client *APIClient
+}
+
+// GCRulesModel describes the resource data model.
+type GCRulesModel struct {
+	Id                   types.String        `tfsdk:"id"`
+	Repository           types.String        `tfsdk:"repository"`
+	DefaultRetentionDays types.Int64         `tfsdk:"default_retention_days"`
+	Branches             []GCBranchRuleModel `tfsdk:"branches"`
+	Timeouts             timeouts.Value      `tfsdk:"timeouts"`
+}
+
+// GCBranchRuleModel describes a per-branch retention override.
+type GCBranchRuleModel struct {
+	Branch        types.String `tfsdk:"branch"`
+	RetentionDays types.Int64  `tfsdk:"retention_days"`
+}
+
+// GCRules represents the garbage collection rules of a repository
+type GCRules struct {
+	DefaultRetentionDays int64          `json:"default_retention_days"`
+	Branches             []GCBranchRule `json:"branches"`
+}
+
+// GCBranchRule represents the retention override for a single branch
+type GCBranchRule struct {
+	BranchID      string `json:"branch_id"`
+	RetentionDays int64  `json:"retention_days"`
+}
+
+func (r *GCRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gc_rules"
+}
+
+func (r *GCRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the garbage collection rules of a LakeFS repository.",
+		MarkdownDescription: `Manages the garbage collection rules of a LakeFS repository.
+
+Garbage collection rules control how long objects that are no longer referenced by a branch are retained before they can be deleted. Destroying this resource removes the rules from the repository.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "lakefs_gc_rules" "example" {
+  repository             = lakefs_repository.example.id
+  default_retention_days = 14
+
+  branches = [
+    { branch = "main", retention_days = 90 },
+    { branch = "dev", retention_days = 3 }
+  ]
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for this resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository ID to apply garbage collection rules to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_retention_days": schema.Int64Attribute{
+				Required:    true,
+				Description: "Number of days to retain unreferenced objects on branches without an override.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"branches": schema.SetNestedAttribute{
+				Optional:    true,
+				Description: "Per-branch retention overrides. Each branch may appear only once.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					uniqueGCBranchesValidator{},
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"branch": schema.StringAttribute{
+							Required:    true,
+							Description: "The branch ID the override applies to.",
+						},
+						"retention_days": schema.Int64Attribute{
+							Required:    true,
+							Description: "Number of days to retain unreferenced objects on this branch.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, gcRulesTimeouts),
+		},
+	}
+}
+
+func (r *GCRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GCRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GCRulesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
+	repository := data.Repository.ValueString()
+	rules := gcRulesFromModel(data)
+
+	tflog.Debug(ctx, "Creating garbage collection rules", map[string]any{
+		"repository": repository,
+		"rules":      rules,
+	})
+
+	// LakeFS uses PUT to set garbage collection rules
+	err := r.client.Put(ctx, fmt.Sprintf("/repositories/%s/settings/gc_rules", repository), rules, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create garbage collection rules: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(repository)
+
+	tflog.Trace(ctx, "Created garbage collection rules", map[string]any{"repository": repository})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GCRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GCRulesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
+	repository := data.Repository.ValueString()
+
+	var result GCRules
+	err := r.client.Get(ctx, fmt.Sprintf("/repositories/%s/settings/gc_rules", repository), &result)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read garbage collection rules: %s", err))
+		return
+	}
+
+	gcRulesToModel(result, &data)
+	data.Id = types.StringValue(repository)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GCRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GCRulesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "update", updateTimeout, &resp.Diagnostics)
+
+	repository := data.Repository.ValueString()
+	rules := gcRulesFromModel(data)
+
+	tflog.Debug(ctx, "Updating garbage collection rules", map[string]any{
+		"repository": repository,
+		"rules":      rules,
+	})
+
+	err := r.client.Put(ctx, fmt.Sprintf("/repositories/%s/settings/gc_rules", repository), rules, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update garbage collection rules: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(repository)
+
+	tflog.Trace(ctx, "Updated garbage collection rules", map[string]any{"repository": repository})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GCRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GCRulesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
+
+	repository := data.Repository.ValueString()
+
+	tflog.Debug(ctx, "Deleting garbage collection rules", map[string]any{"repository": repository})
+
+	err := r.client.Delete(ctx, fmt.Sprintf("/repositories/%s/settings/gc_rules", repository))
+	if err != nil {
+		if !IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete garbage collection rules: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted garbage collection rules", map[string]any{"repository": repository})
+}
+
+func (r *GCRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	repository := req.ID
+
+	var result GCRules
+	err := r.client.Get(ctx, fmt.Sprintf("/repositories/%s/settings/gc_rules", repository), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import garbage collection rules for %s: %s", repository, err))
+		return
+	}
+
+	var data GCRulesModel
+	data.Timeouts = nullTimeouts(ctx, gcRulesTimeouts)
+	data.Id = types.StringValue(repository)
+	data.Repository = types.StringValue(repository)
+	gcRulesToModel(result, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// gcRulesFromModel converts the Terraform model into the API request body
+func gcRulesFromModel(data GCRulesModel) GCRules {
+	rules := GCRules{
+		DefaultRetentionDays: data.DefaultRetentionDays.ValueInt64(),
+		Branches:             []GCBranchRule{},
+	}
+
+	for _, branch := range data.Branches {
+		rules.Branches = append(rules.Branches, GCBranchRule{
+			BranchID:      branch.Branch.ValueString(),
+			RetentionDays: branch.RetentionDays.ValueInt64(),
+		})
+	}
+
+	return rules
+}
+
+// gcRulesToModel maps the API response into the Terraform model
+func gcRulesToModel(rules GCRules, data *GCRulesModel) {
+	data.DefaultRetentionDays = types.Int64Value(rules.DefaultRetentionDays)
+
+	// No overrides are stored as null to match configurations that omit branches
+	data.Branches = nil
+	for _, branch := range rules.Branches {
+		data.Branches = append(data.Branches, GCBranchRuleModel{
+			Branch:        types.StringValue(branch.BranchID),
+			RetentionDays: types.Int64Value(branch.RetentionDays),
+		})
+	}
+}
+
+var _ validator.Set = uniqueGCBranchesValidator{}
+
+// uniqueGCBranchesValidator rejects branch overrides that name the same branch more than once,
+// which the set itself allows as long as the retention differs.
+type uniqueGCBranchesValidator struct{}
+
+func (v uniqueGCBranchesValidator) Description(ctx context.Context) string {
+	return "each branch must appear only once"
+}
+
+func (v uniqueGCBranchesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniqueGCBranchesValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, elem := range req.ConfigValue.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		branch, ok := obj.Attributes()["branch"].(types.String)
+		if !ok || branch.IsNull() || branch.IsUnknown() {
+			continue
+		}
+
+		if seen[branch.ValueString()] {
+			resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+				req.Path,
+				"Duplicate Branch",
+				fmt.Sprintf("Branch %q has more than one retention override; %s.", branch.ValueString(), v.Description(ctx)),
+			))
+			continue
+		}
+		seen[branch.ValueString()] = true
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUniqueGCBranchesValidator(t *testing.T) {
+	ruleType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"branch":         types.StringType,
+		"retention_days": types.Int64Type,
+	}}
+	rule := func(branch types.String, days int64) attr.Value {
+		return types.ObjectValueMust(ruleType.AttrTypes, map[string]attr.Value{
+			"branch":         branch,
+			"retention_days": types.Int64Value(days),
+		})
+	}
+
+	tests := map[string]struct {
+		value   types.Set
+		wantErr bool
+	}{
+		"null":    {value: types.SetNull(ruleType)},
+		"unknown": {value: types.SetUnknown(ruleType)},
+		"distinct branches": {
+			value: types.SetValueMust(ruleType, []attr.Value{
+				rule(types.StringValue("main"), 90),
+				rule(types.StringValue("dev"), 3),
+			}),
+		},
+		"duplicate branch": {
+			value: types.SetValueMust(ruleType, []attr.Value{
+				rule(types.StringValue("main"), 90),
+				rule(types.StringValue("main"), 30),
+			}),
+			wantErr: true,
+		},
+		"unknown branch": {
+			value: types.SetValueMust(ruleType, []attr.Value{
+				rule(types.StringUnknown(), 90),
+				rule(types.StringUnknown(), 30),
+			}),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.SetRequest{Path: path.Root("branches"), ConfigValue: tt.value}
+			resp := &validator.SetResponse{}
+
+			uniqueGCBranchesValidator{}.ValidateSet(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,436 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupMembershipResource{}
+var _ resource.ResourceWithImportState = &GroupMembershipResource{}
+
+var groupMembershipTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Update: true,
+	Delete: true,
+}
+
+func NewGroupMembershipResource() resource.Resource {
+	return &GroupMembershipResource{}
+}
+
+// GroupMembershipResource defines the resource implementation.
+type GroupMembershipResource struct {
+	client *APIClient
+}
+
+// GroupMembershipModel describes the resource data model.
+type GroupMembershipModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Group    types.String   `tfsdk:"group"`
+	Users    types.Set      `tfsdk:"users"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *GroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+func (r *GroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the set of users that belong to a LakeFS group.",
+		MarkdownDescription: `Manages the set of users that belong to a LakeFS group.
+
+This resource is authoritative: users already in the group when it is created are removed, and users added outside of Terraform later are reported as drift and removed on the next apply. This resource requires LakeFS Enterprise or LakeFS Cloud.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "lakefs_group_membership" "data_engineers" {
+  group = lakefs_group.data_engineers.id
+  users = [
+    lakefs_user.engineer.id,
+  ]
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for this resource. Same as the group ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Required:    true,
+				Description: "The group ID whose members are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The set of user IDs that belong to the group.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, groupMembershipTimeouts),
+		},
+	}
+}
+
+func (r *GroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GroupMembershipModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
+	group := data.Group.ValueString()
+
+	var users []string
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resource is authoritative, so members added outside Terraform are removed on create too
+	current, err := listGroupMembers(ctx, r.client, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read members of group %s: %s", group, err))
+		return
+	}
+
+	toAdd, toRemove := diffStringSets(current, users)
+
+	tflog.Debug(ctx, "Creating group membership", map[string]any{
+		"group":  group,
+		"add":    toAdd,
+		"remove": toRemove,
+	})
+
+	// applied tracks the actual members as changes are made, so a partial failure can be saved to state
+	applied := make(map[string]bool, len(current))
+	for _, user := range current {
+		applied[user] = true
+	}
+
+	for _, user := range toRemove {
+		err := r.client.Delete(ctx, fmt.Sprintf("/auth/groups/%s/members/%s", group, user))
+		if err != nil && !IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove user %s from group %s: %s", user, group, err))
+			savePartialMembership(ctx, &resp.State, &resp.Diagnostics, data, applied)
+			return
+		}
+		delete(applied, user)
+	}
+
+	for _, user := range toAdd {
+		err := r.client.Put(ctx, fmt.Sprintf("/auth/groups/%s/members/%s", group, user), nil, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add user %s to group %s: %s", user, group, err))
+			savePartialMembership(ctx, &resp.State, &resp.Diagnostics, data, applied)
+			return
+		}
+		applied[user] = true
+	}
+
+	data.Id = types.StringValue(group)
+
+	tflog.Trace(ctx, "Created group membership", map[string]any{"group": group})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GroupMembershipModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
+	group := data.Group.ValueString()
+
+	members, err := listGroupMembers(ctx, r.client, group)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group members: %s", err))
+		return
+	}
+
+	users, diags := types.SetValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Users = users
+	data.Id = types.StringValue(group)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state GroupMembershipModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "update", updateTimeout, &resp.Diagnostics)
+
+	group := data.Group.ValueString()
+
+	var planned, current []string
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toAdd, toRemove := diffStringSets(current, planned)
+
+	tflog.Debug(ctx, "Updating group membership", map[string]any{
+		"group":  group,
+		"add":    toAdd,
+		"remove": toRemove,
+	})
+
+	applied := make(map[string]bool, len(current))
+	for _, user := range current {
+		applied[user] = true
+	}
+
+	for _, user := range toRemove {
+		err := r.client.Delete(ctx, fmt.Sprintf("/auth/groups/%s/members/%s", group, user))
+		if err != nil && !IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove user %s from group %s: %s", user, group, err))
+			savePartialMembership(ctx, &resp.State, &resp.Diagnostics, data, applied)
+			return
+		}
+		delete(applied, user)
+	}
+
+	for _, user := range toAdd {
+		err := r.client.Put(ctx, fmt.Sprintf("/auth/groups/%s/members/%s", group, user), nil, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add user %s to group %s: %s", user, group, err))
+			savePartialMembership(ctx, &resp.State, &resp.Diagnostics, data, applied)
+			return
+		}
+		applied[user] = true
+	}
+
+	data.Id = types.StringValue(group)
+
+	tflog.Trace(ctx, "Updated group membership", map[string]any{"group": group})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GroupMembershipModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
+
+	group := data.Group.ValueString()
+
+	var users []string
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting group membership", map[string]any{
+		"group": group,
+		"users": users,
+	})
+
+	for _, user := range users {
+		err := r.client.Delete(ctx, fmt.Sprintf("/auth/groups/%s/members/%s", group, user))
+		if err != nil {
+			if !IsNotFound(err) {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove user %s from group %s: %s", user, group, err))
+				return
+			}
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted group membership", map[string]any{"group": group})
+}
+
+func (r *GroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: group. The resource manages every member of the group, so a single
+	// membership cannot be imported on its own.
+	group := req.ID
+	if group == "" || strings.Contains(group, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'group', got: %s. "+
+				"lakefs_group_membership manages all members of a group, so it is imported by group ID.", req.ID),
+		)
+		return
+	}
+
+	members, err := listGroupMembers(ctx, r.client, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import group membership %s: %s", req.ID, err))
+		return
+	}
+
+	users, diags := types.SetValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data GroupMembershipModel
+	data.Timeouts = nullTimeouts(ctx, groupMembershipTimeouts)
+	data.Id = types.StringValue(group)
+	data.Group = types.StringValue(group)
+	data.Users = users
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// savePartialMembership records the members a failed create or update left in the group, so
+// Terraform tracks them and the next apply converges instead of leaving them outside state
+func savePartialMembership(ctx context.Context, state *tfsdk.State, diagnostics *diag.Diagnostics, data GroupMembershipModel, applied map[string]bool) {
+	members := make([]string, 0, len(applied))
+	for user := range applied {
+		members = append(members, user)
+	}
+	sort.Strings(members)
+
+	users, diags := types.SetValueFrom(ctx, types.StringType, members)
+	diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	data.Id = data.Group
+	data.Users = users
+	diagnostics.Append(state.Set(ctx, &data)...)
+}
+
+// listGroupMembers returns the IDs of all users in a group
+func listGroupMembers(ctx context.Context, client *APIClient, group string) ([]string, error) {
+	members := []string{}
+
+	for user, err := range Paginate[UserResponse](ctx, client, fmt.Sprintf("/auth/groups/%s/members", group), ListOptions{}) {
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, user.ID)
+	}
+
+	sort.Strings(members)
+	return members, nil
+}
+
+// diffStringSets returns the elements that must be added to and removed from
+// current in order to match desired
+func diffStringSets(current, desired []string) (toAdd, toRemove []string) {
+	currentSet := make(map[string]bool, len(current))
+	for _, v := range current {
+		currentSet[v] = true
+	}
+
+	desiredSet := make(map[string]bool, len(desired))
+	for _, v := range desired {
+		desiredSet[v] = true
+		if !currentSet[v] {
+			toAdd = append(toAdd, v)
+		}
+	}
+
+	for _, v := range current {
+		if !desiredSet[v] {
+			toRemove = append(toRemove, v)
+		}
+	}
+
+	return toAdd, toRemove
+}
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupPolicyAttachmentResource{}
+var _ resource.ResourceWithImportState = &GroupPolicyAttachmentResource{}
+
+// groupPolicyAttachmentTimeouts has no update timeout, since every argument forces replacement.
+var groupPolicyAttachmentTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Delete: true,
+}
+
+func NewGroupPolicyAttachmentResource() resource.Resource {
+	return &GroupPolicyAttachmentResource{}
+}
+
+// GroupPolicyAttachmentResource defines the resource implementation.
+type GroupPolicyAttachmentResource struct {
+	client *APIClient
+}
+
+// GroupPolicyAttachmentModel describes the resource data model.
+type GroupPolicyAttachmentModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Group    types.String   `tfsdk:"group"`
+	Policy   types.String   `tfsdk:"policy"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *GroupPolicyAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_policy_attachment"
+}
+
+func (r *GroupPolicyAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a LakeFS policy to a group.",
+		MarkdownDescription: `Attaches a LakeFS policy to a group.
+
+This resource requires LakeFS Enterprise or LakeFS Cloud.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "lakefs_group_policy_attachment" "engineers_read" {
+  group  = lakefs_group.data_engineers.id
+  policy = lakefs_policy.read_example.id
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for this resource, in the format 'group/policy'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Required:    true,
+				Description: "The group ID to attach the policy to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Required:    true,
+				Description: "The policy ID to attach.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, groupPolicyAttachmentTimeouts),
+		},
+	}
+}
+
+func (r *GroupPolicyAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupPolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GroupPolicyAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
+	group := data.Group.ValueString()
+	policy := data.Policy.ValueString()
+
+	tflog.Debug(ctx, "Attaching policy to group", map[string]any{
+		"group":  group,
+		"policy": policy,
+	})
+
+	err := r.client.Put(ctx, fmt.Sprintf("/auth/groups/%s/policies/%s", group, policy), nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to attach policy to group: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", group, policy))
+
+	tflog.Trace(ctx, "Attached policy to group", map[string]any{"id": data.Id.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupPolicyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GroupPolicyAttachmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
+	group := data.Group.ValueString()
+	policy := data.Policy.ValueString()
+
+	attached, err := isPolicyAttached(ctx, r.client, fmt.Sprintf("/auth/groups/%s/policies", group), policy)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group policies: %s", err))
+		return
+	}
+
+	if !attached {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", group, policy))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupPolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GroupPolicyAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All attributes force replacement, so there is nothing to update in LakeFS
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupPolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GroupPolicyAttachmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
+
+	group := data.Group.ValueString()
+	policy := data.Policy.ValueString()
+
+	tflog.Debug(ctx, "Detaching policy from group", map[string]any{
+		"group":  group,
+		"policy": policy,
+	})
+
+	err := r.client.Delete(ctx, fmt.Sprintf("/auth/groups/%s/policies/%s", group, policy))
+	if err != nil {
+		if !IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach policy from group: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Detached policy from group", map[string]any{
+		"group":  group,
+		"policy": policy,
+	})
+}
+
+func (r *GroupPolicyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: group/policy
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'group/policy', got: %s", req.ID),
+		)
+		return
+	}
+
+	group := parts[0]
+	policy := parts[1]
+
+	attached, err := isPolicyAttached(ctx, r.client, fmt.Sprintf("/auth/groups/%s/policies", group), policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import group policy attachment %s: %s", req.ID, err))
+		return
+	}
+	if !attached {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Policy %s is not attached to group %s", policy, group))
+		return
+	}
+
+	var data GroupPolicyAttachmentModel
+	data.Timeouts = nullTimeouts(ctx, groupPolicyAttachmentTimeouts)
+	data.Id = types.StringValue(req.ID)
+	data.Group = types.StringValue(group)
+	data.Policy = types.StringValue(policy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
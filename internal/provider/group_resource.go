@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupResource{}
+var _ resource.ResourceWithImportState = &GroupResource{}
+
+// groupTimeouts has no update timeout, since groups are replaced rather than updated.
+var groupTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Delete: true,
+}
+
+func NewGroupResource() resource.Resource {
+	return &GroupResource{}
+}
+
+// GroupResource defines the resource implementation.
+type GroupResource struct {
+	client *APIClient
+}
+
+// GroupModel describes the resource data model.
+type GroupModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Description  types.String   `tfsdk:"description"`
+	CreationDate types.Int64    `tfsdk:"creation_date"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GroupCreateRequest represents the request to create a group
+type GroupCreateRequest struct {
+	ID          string `json:"id"`
+	Description string `json:"description,omitempty"`
+}
+
+// GroupResponse represents the API response for a group
+type GroupResponse struct {
+	ID           string `json:"id"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	CreationDate int64  `json:"creation_date"`
+}
+
+func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a LakeFS group.",
+		MarkdownDescription: `Manages a LakeFS group.
+
+Groups cannot be renamed; changing any attribute replaces the group. Use ` + "`lakefs_group_membership`" + ` to manage its members. This resource requires LakeFS Enterprise or LakeFS Cloud.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "lakefs_group" "data_engineers" {
+  id          = "data-engineers"
+  description = "Engineers working on the ingestion pipelines"
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "A unique identifier for the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "A description of the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_date": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Unix epoch timestamp when the group was created.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, groupTimeouts),
+		},
+	}
+}
+
+func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
+	createReq := GroupCreateRequest{
+		ID: data.Id.ValueString(),
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		createReq.Description = data.Description.ValueString()
+	}
+
+	tflog.Debug(ctx, "Creating group", map[string]any{"id": createReq.ID})
+
+	var result GroupResponse
+	err := r.client.Post(ctx, "/auth/groups", createReq, &result)
+	if err != nil {
+		if IsConflict(err) {
+			resp.Diagnostics.AddError("Group Already Exists", alreadyExistsDetail("group", createReq.ID, "lakefs_group", createReq.ID, err))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group: %s", err))
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(result.ID)
+	data.Description = types.StringValue(result.Description)
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	tflog.Trace(ctx, "Created group", map[string]any{"id": result.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GroupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
+	var result GroupResponse
+	err := r.client.Get(ctx, fmt.Sprintf("/auth/groups/%s", data.Id.ValueString()), &result)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group: %s", err))
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(result.ID)
+	data.Description = types.StringValue(result.Description)
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All group attributes force replacement, so there is nothing to update in LakeFS
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GroupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
+
+	groupID := data.Id.ValueString()
+
+	tflog.Debug(ctx, "Deleting group", map[string]any{"id": groupID})
+
+	err := r.client.Delete(ctx, fmt.Sprintf("/auth/groups/%s", groupID))
+	if err != nil {
+		if !IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted group", map[string]any{"id": groupID})
+}
+
+func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var result GroupResponse
+	err := r.client.Get(ctx, fmt.Sprintf("/auth/groups/%s", req.ID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import group %s: %s", req.ID, err))
+		return
+	}
+
+	var data GroupModel
+	data.Timeouts = nullTimeouts(ctx, groupTimeouts)
+	data.Id = types.StringValue(result.ID)
+	data.Description = types.StringValue(result.Description)
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupsDataSource{}
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &GroupsDataSource{}
+}
+
+// GroupsDataSource defines the data source implementation.
+type GroupsDataSource struct {
+	client *APIClient
+}
+
+// GroupsModel describes the data source data model.
+type GroupsModel struct {
+	Prefix types.String     `tfsdk:"prefix"`
+	Groups []GroupItemModel `tfsdk:"groups"`
+}
+
+// GroupItemModel describes a single group in the list.
+type GroupItemModel struct {
+	Id           types.String `tfsdk:"id"`
+	Description  types.String `tfsdk:"description"`
+	CreationDate types.Int64  `tfsdk:"creation_date"`
+}
+
+func (d *GroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists LakeFS groups.",
+		MarkdownDescription: `Lists LakeFS groups.
+
+This data source requires LakeFS Enterprise or LakeFS Cloud.
+
+## Example Usage
+
+` + "```hcl" + `
+data "lakefs_groups" "teams" {
+  prefix = "team-"
+}
+
+output "team_ids" {
+  value = data.lakefs_groups.teams.groups[*].id
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return groups whose ID starts with this prefix.",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The groups matching the filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the group.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "A description of the group.",
+						},
+						"creation_date": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Unix epoch timestamp when the group was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if prefix := data.Prefix.ValueString(); prefix != "" {
+		query.Set("prefix", prefix)
+	}
+
+	data.Groups = []GroupItemModel{}
+	for group, err := range Paginate[GroupResponse](ctx, d.client, "/auth/groups", ListOptions{Query: query}) {
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list groups: %s", err))
+			return
+		}
+
+		data.Groups = append(data.Groups, GroupItemModel{
+			Id:           types.StringValue(group.ID),
+			Description:  types.StringValue(group.Description),
+			CreationDate: types.Int64Value(group.CreationDate),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
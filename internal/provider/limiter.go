@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// errRateLimitPastDeadline is returned when waiting for the rate limit would outlast the caller's
+// deadline. It also matches context.DeadlineExceeded, but unlike a real deadline the context is
+// still live, so the request must not be retried: the next attempt would be refused the same way.
+var errRateLimitPastDeadline = fmt.Errorf("rate limit wait exceeds the operation deadline: %w", context.DeadlineExceeded)
+
+// requestLimiter bounds how many API requests are in flight at once and how fast new ones start.
+// A single limiter is shared by every resource through the APIClient, so the limits apply to the
+// provider as a whole regardless of Terraform's -parallelism. A nil limiter imposes no limits.
+type requestLimiter struct {
+	// slots holds one entry per in-flight request; nil when concurrency is unlimited.
+	slots chan struct{}
+
+	mu     sync.Mutex
+	rate   float64 // tokens added per second; zero when the rate is unlimited
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newRequestLimiter returns a limiter allowing maxConcurrent requests in flight and perSecond
+// request starts per second. A zero value disables the corresponding limit; if both are zero
+// newRequestLimiter returns nil.
+func newRequestLimiter(maxConcurrent int, perSecond float64) *requestLimiter {
+	if maxConcurrent <= 0 && perSecond <= 0 {
+		return nil
+	}
+
+	l := &requestLimiter{}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	if perSecond > 0 {
+		l.rate = perSecond
+		// Allow up to one second's worth of requests to start together after an idle period
+		l.burst = math.Max(1, math.Floor(perSecond))
+		l.tokens = l.burst
+		l.last = time.Now()
+	}
+	return l
+}
+
+// acquire blocks until the request may start, then returns a function that must be called once
+// the request has finished. It gives up as soon as ctx is done, or straight away if the rate
+// limit would hold the request past ctx's deadline.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	start := time.Now()
+
+	rateWait, err := l.waitForToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slotStart := time.Now()
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for a free request slot: %w", ctx.Err())
+		}
+	}
+	slotWait := time.Since(slotStart)
+
+	if total := time.Since(start); total > time.Millisecond {
+		tflog.Debug(ctx, "Request delayed by provider rate limits", map[string]any{
+			"rate_limit_wait":  rateWait.String(),
+			"concurrency_wait": slotWait.String(),
+			"total_wait":       total.String(),
+		})
+	}
+
+	return l.release, nil
+}
+
+// release frees the slot taken by acquire
+func (l *requestLimiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// waitForToken takes a token from the bucket, sleeping until one is available
+func (l *requestLimiter) waitForToken(ctx context.Context) (time.Duration, error) {
+	if l.rate <= 0 {
+		return 0, nil
+	}
+
+	wait := l.reserve()
+	if wait <= 0 {
+		return 0, nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+		l.refund()
+		return 0, fmt.Errorf("waiting %s for requests_per_second: %w", wait, errRateLimitPastDeadline)
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return wait, nil
+	case <-ctx.Done():
+		l.refund()
+		return 0, fmt.Errorf("waiting for rate limit: %w", ctx.Err())
+	}
+}
+
+// reserve refills the bucket, takes one token and returns how long the caller must wait for it
+func (l *requestLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// refund returns a reserved token to the bucket when the caller gives up waiting for it
+func (l *requestLimiter) refund() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
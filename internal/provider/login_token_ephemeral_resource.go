@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &LoginTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &LoginTokenEphemeralResource{}
+
+func NewLoginTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &LoginTokenEphemeralResource{}
+}
+
+// LoginTokenEphemeralResource defines the ephemeral resource implementation.
+type LoginTokenEphemeralResource struct {
+	client *APIClient
+}
+
+// LoginTokenModel describes the ephemeral resource data model.
+type LoginTokenModel struct {
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	Token           types.String `tfsdk:"token"`
+	TokenExpiration types.Int64  `tfsdk:"token_expiration"`
+}
+
+// LoginRequest represents the request to log in to LakeFS
+type LoginRequest struct {
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key"`
+}
+
+// LoginTokenResponse represents the API response for a login
+type LoginTokenResponse struct {
+	Token           string `json:"token"`
+	TokenExpiration int64  `json:"token_expiration"`
+}
+
+func (e *LoginTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_login_token"
+}
+
+func (e *LoginTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a short-lived LakeFS JWT that is never persisted to the Terraform plan or state.",
+		MarkdownDescription: `Issues a short-lived LakeFS JWT that is never persisted to the Terraform plan or state.
+
+The token is obtained from ` + "`/auth/login`" + ` during the run and can be passed to other providers or write-only attributes in the same apply. By default the provider's own credentials are used.
+
+## Example Usage
+
+` + "```hcl" + `
+ephemeral "lakefs_login_token" "ci" {}
+
+provider "restapi" {
+  uri = "http://localhost:8000/api/v1"
+  headers = {
+    Authorization = "Bearer ${ephemeral.lakefs_login_token.ci.token}"
+  }
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The access key ID to log in with. Defaults to the provider's access key ID.",
+			},
+			"secret_access_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The secret access key to log in with. Defaults to the provider's secret access key.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The JWT issued by LakeFS.",
+			},
+			"token_expiration": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Unix epoch timestamp when the token expires.",
+			},
+		},
+	}
+}
+
+func (e *LoginTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+func (e *LoginTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data LoginTokenModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loginReq := LoginRequest{
+		AccessKeyID:     e.client.Username,
+		SecretAccessKey: e.client.Password,
+	}
+
+	if !data.AccessKeyID.IsNull() && !data.AccessKeyID.IsUnknown() {
+		loginReq.AccessKeyID = data.AccessKeyID.ValueString()
+	}
+
+	if !data.SecretAccessKey.IsNull() && !data.SecretAccessKey.IsUnknown() {
+		loginReq.SecretAccessKey = data.SecretAccessKey.ValueString()
+	}
+
+	tflog.Debug(ctx, "Requesting login token")
+
+	var result LoginTokenResponse
+	err := e.client.Post(ctx, "/auth/login", loginReq, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to obtain login token: %s", err))
+		return
+	}
+
+	data.Token = types.StringValue(result.Token)
+	data.TokenExpiration = types.Int64Value(result.TokenExpiration)
+
+	tflog.Trace(ctx, "Obtained login token", map[string]any{
+		"expires_at": time.Unix(result.TokenExpiration, 0).UTC().Format(time.RFC3339),
+	})
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
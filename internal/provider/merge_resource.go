@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MergeResource{}
+
+// mergeTimeouts has no update timeout, since a merge cannot be changed after the fact.
+var mergeTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Delete: true,
+}
+
+func NewMergeResource() resource.Resource {
+	return &MergeResource{}
+}
+
+// MergeResource defines the resource implementation.
+type MergeResource struct {
+	client *APIClient
+}
+
+// MergeModel describes the resource data model.
+type MergeModel struct {
+	Id                types.String   `tfsdk:"id"`
+	Repository        types.String   `tfsdk:"repository"`
+	SourceRef         types.String   `tfsdk:"source_ref"`
+	DestinationBranch types.String   `tfsdk:"destination_branch"`
+	Message           types.String   `tfsdk:"message"`
+	Metadata          types.Map      `tfsdk:"metadata"`
+	Strategy          types.String   `tfsdk:"strategy"`
+	SquashMerge       types.Bool     `tfsdk:"squash_merge"`
+	Force             types.Bool     `tfsdk:"force"`
+	MergeCommitId     types.String   `tfsdk:"merge_commit_id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// MergeRequest represents the request to merge one ref into a branch
+type MergeRequest struct {
+	Message     string            `json:"message,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	Strategy    string            `json:"strategy,omitempty"`
+	SquashMerge bool              `json:"squash_merge,omitempty"`
+	Force       bool              `json:"force,omitempty"`
+}
+
+// MergeResponse represents the API response for a merge
+type MergeResponse struct {
+	Reference string `json:"reference"`
+}
+
+func (r *MergeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_merge"
+}
+
+func (r *MergeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Merges a LakeFS ref into a branch.",
+		MarkdownDescription: `Merges a LakeFS ref into a branch.
+
+The merge is performed once, when the resource is created. Changing any argument performs a new merge. Destroying this resource only removes it from the Terraform state; the merge commit remains in the destination branch history.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "lakefs_merge" "promote_to_staging" {
+  repository         = lakefs_repository.example.id
+  source_ref         = "dev"
+  destination_branch = "staging"
+  message            = "Promote dev to staging"
+  strategy           = "source-wins"
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for this resource, in the format 'repository/merge_commit_id'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository containing both refs.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_ref": schema.StringAttribute{
+				Required:    true,
+				Description: "The branch, tag or commit ID to merge from.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_branch": schema.StringAttribute{
+				Required:    true,
+				Description: "The branch to merge into.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Optional:    true,
+				Description: "The merge commit message. LakeFS generates one if not set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Key/value metadata to attach to the merge commit.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"strategy": schema.StringAttribute{
+				Optional:    true,
+				Description: "How to resolve conflicts: 'dest-wins' or 'source-wins'. If not set, the merge fails on conflicts.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("dest-wins", "source-wins"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"squash_merge": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Create a single commit with the changes instead of a merge commit. Default is false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Allow merging into a read-only branch or into a branch with the same content. Default is false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"merge_commit_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the commit created by the merge.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, mergeTimeouts),
+		},
+	}
+}
+
+func (r *MergeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MergeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MergeModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
+	repository := data.Repository.ValueString()
+	source := data.SourceRef.ValueString()
+	destination := data.DestinationBranch.ValueString()
+
+	mergeReq := MergeRequest{
+		Message:     data.Message.ValueString(),
+		Strategy:    data.Strategy.ValueString(),
+		SquashMerge: data.SquashMerge.ValueBool(),
+		Force:       data.Force.ValueBool(),
+	}
+
+	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+		resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &mergeReq.Metadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Merging ref", map[string]any{
+		"repository":  repository,
+		"source":      source,
+		"destination": destination,
+		"strategy":    mergeReq.Strategy,
+	})
+
+	var result MergeResponse
+	err := r.client.Post(ctx, fmt.Sprintf("/repositories/%s/refs/%s/merge/%s", repository, source, destination), mergeReq, &result)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusConflict {
+			resp.Diagnostics.AddError(
+				"Merge Conflict",
+				fmt.Sprintf("Unable to merge %q into %q in repository %q because both refs changed the same paths. "+
+					"Resolve the conflicts on the source ref, or set strategy to \"source-wins\" or \"dest-wins\".\n\n"+
+					"LakeFS reported: %s", source, destination, repository, apiErr.Message),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to merge %s into %s: %s%s", source, destination, err, actionRunHint(err)))
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", repository, result.Reference))
+	data.MergeCommitId = types.StringValue(result.Reference)
+
+	tflog.Trace(ctx, "Merged ref", map[string]any{
+		"repository":      repository,
+		"merge_commit_id": result.Reference,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MergeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MergeModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
+	var result CommitResponse
+	err := r.client.Get(ctx, fmt.Sprintf("/repositories/%s/commits/%s", data.Repository.ValueString(), data.MergeCommitId.ValueString()), &result)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read merge commit: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MergeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MergeModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A merge cannot be changed after the fact - every argument forces replacement
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MergeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MergeModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Merge commits cannot be removed from a branch's history, so the merge is left in place
+	tflog.Debug(ctx, "Removing merge from state without changing the branch", map[string]any{
+		"repository":      data.Repository.ValueString(),
+		"merge_commit_id": data.MergeCommitId.ValueString(),
+	})
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultMaxContentBytes is the largest object body read when max_content_bytes is not set.
+const defaultMaxContentBytes = 1024 * 1024
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ObjectDataSource{}
+
+func NewObjectDataSource() datasource.DataSource {
+	return &ObjectDataSource{}
+}
+
+// ObjectDataSource defines the data source implementation.
+type ObjectDataSource struct {
+	client *APIClient
+}
+
+// ObjectDataSourceModel describes the data source data model.
+type ObjectDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Repository      types.String `tfsdk:"repository"`
+	Ref             types.String `tfsdk:"ref"`
+	Path            types.String `tfsdk:"path"`
+	IncludeContent  types.Bool   `tfsdk:"include_content"`
+	MaxContentBytes types.Int64  `tfsdk:"max_content_bytes"`
+	Checksum        types.String `tfsdk:"checksum"`
+	SizeBytes       types.Int64  `tfsdk:"size_bytes"`
+	Mtime           types.Int64  `tfsdk:"mtime"`
+	PhysicalAddress types.String `tfsdk:"physical_address"`
+	ContentType     types.String `tfsdk:"content_type"`
+	Metadata        types.Map    `tfsdk:"metadata"`
+	Content         types.String `tfsdk:"content"`
+}
+
+func (d *ObjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object"
+}
+
+func (d *ObjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the metadata and, optionally, the content of a LakeFS object.",
+		MarkdownDescription: `Reads the metadata and, optionally, the content of a LakeFS object.
+
+## Example Usage
+
+` + "```hcl" + `
+data "lakefs_object" "manifest" {
+  repository      = lakefs_repository.example.id
+  ref             = "v1.0.0"
+  path            = "manifest.json"
+  include_content = true
+}
+
+locals {
+  manifest = jsondecode(data.lakefs_object.manifest.content)
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier of the object, in the format 'repository/ref/path'.",
+			},
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository containing the object.",
+			},
+			"ref": schema.StringAttribute{
+				Required:    true,
+				Description: "The branch, tag or commit ID to read the object from.",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the object.",
+			},
+			"include_content": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Read the object body into content. The body must be UTF-8 text. Default is false.",
+			},
+			"max_content_bytes": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The largest object body to read when include_content is true. Default is 1048576 (1 MiB).",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				Computed:    true,
+				Description: "The checksum of the object.",
+			},
+			"size_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The size of the object in bytes.",
+			},
+			"mtime": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Unix epoch timestamp when the object was last modified.",
+			},
+			"physical_address": schema.StringAttribute{
+				Computed:    true,
+				Description: "The location of the object in the underlying storage.",
+			},
+			"content_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The MIME type of the object.",
+			},
+			"metadata": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "User metadata attached to the object.",
+			},
+			"content": schema.StringAttribute{
+				Computed:    true,
+				Description: "The object body. Only set when include_content is true.",
+			},
+		},
+	}
+}
+
+func (d *ObjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ObjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ObjectDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repository := data.Repository.ValueString()
+	ref := data.Ref.ValueString()
+	objectPath := data.Path.ValueString()
+
+	result, err := statObject(ctx, d.client, repository, ref, objectPath)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read object: %s", err))
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", repository, ref, objectPath))
+	data.Checksum = types.StringValue(result.Checksum)
+	data.SizeBytes = types.Int64Value(result.SizeBytes)
+	data.Mtime = types.Int64Value(result.Mtime)
+	data.PhysicalAddress = types.StringValue(result.PhysicalAddress)
+	data.ContentType = types.StringValue(result.ContentType)
+
+	metadata, diags := types.MapValueFrom(ctx, types.StringType, result.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Metadata = metadata
+	data.Content = types.StringNull()
+
+	if data.IncludeContent.ValueBool() {
+		maxBytes := int64(defaultMaxContentBytes)
+		if !data.MaxContentBytes.IsNull() {
+			maxBytes = data.MaxContentBytes.ValueInt64()
+		}
+
+		if result.SizeBytes > maxBytes {
+			resp.Diagnostics.AddError(
+				"Object Too Large",
+				fmt.Sprintf("Object %s is %d bytes, which is larger than max_content_bytes (%d). Increase max_content_bytes or set include_content to false.", objectPath, result.SizeBytes, maxBytes),
+			)
+			return
+		}
+
+		tflog.Debug(ctx, "Reading object content", map[string]any{
+			"repository": repository,
+			"ref":        ref,
+			"path":       objectPath,
+			"size":       result.SizeBytes,
+		})
+
+		content, err := d.client.GetRaw(ctx, fmt.Sprintf("/repositories/%s/refs/%s/objects", repository, ref), url.Values{"path": {objectPath}})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read object content: %s", err))
+			return
+		}
+
+		if !utf8.Valid(content) {
+			resp.Diagnostics.AddError(
+				"Unsupported Object Content",
+				fmt.Sprintf("Object %s is not UTF-8 text and cannot be stored in content.", objectPath),
+			)
+			return
+		}
+
+		data.Content = types.StringValue(string(content))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,508 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ObjectResource{}
+var _ resource.ResourceWithImportState = &ObjectResource{}
+var _ resource.ResourceWithModifyPlan = &ObjectResource{}
+
+var objectTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Update: true,
+	Delete: true,
+}
+
+func NewObjectResource() resource.Resource {
+	return &ObjectResource{}
+}
+
+// ObjectResource defines the resource implementation.
+type ObjectResource struct {
+	client *APIClient
+}
+
+// ObjectModel describes the resource data model.
+type ObjectModel struct {
+	Id            types.String   `tfsdk:"id"`
+	Repository    types.String   `tfsdk:"repository"`
+	Branch        types.String   `tfsdk:"branch"`
+	Path          types.String   `tfsdk:"path"`
+	Content       types.String   `tfsdk:"content"`
+	Source        types.String   `tfsdk:"source"`
+	ContentType   types.String   `tfsdk:"content_type"`
+	ContentSha256 types.String   `tfsdk:"content_sha256"`
+	Checksum      types.String   `tfsdk:"checksum"`
+	SizeBytes     types.Int64    `tfsdk:"size_bytes"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ObjectStats represents the API response for an object's metadata
+type ObjectStats struct {
+	Path                  string            `json:"path"`
+	PathType              string            `json:"path_type"`
+	PhysicalAddress       string            `json:"physical_address"`
+	PhysicalAddressExpiry int64             `json:"physical_address_expiry,omitempty"`
+	Checksum              string            `json:"checksum"`
+	SizeBytes             int64             `json:"size_bytes"`
+	Mtime                 int64             `json:"mtime"`
+	ContentType           string            `json:"content_type,omitempty"`
+	Metadata              map[string]string `json:"metadata,omitempty"`
+}
+
+func (r *ObjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object"
+}
+
+func (r *ObjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads an object to a LakeFS branch.",
+		MarkdownDescription: `Uploads an object to a LakeFS branch.
+
+The object is uploaded as an uncommitted change; use ` + "`lakefs_commit`" + ` to commit it. If the object is changed outside of Terraform, the next apply uploads the configured content again.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "lakefs_object" "schema" {
+  repository   = lakefs_repository.example.id
+  branch       = "main"
+  path         = "schemas/events.json"
+  source       = "${path.module}/schemas/events.json"
+  content_type = "application/json"
+}
+
+resource "lakefs_object" "readme" {
+  repository = lakefs_repository.example.id
+  branch     = "main"
+  path       = "README.md"
+  content    = "Reference datasets managed by Terraform."
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for this resource, in the format 'repository/branch/path'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository to upload the object to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Required:    true,
+				Description: "The branch to upload the object to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the object within the branch.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "The literal content of the object. Exactly one of content or source must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content"), path.MatchRoot("source")),
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a local file to upload. Exactly one of content or source must be set.",
+			},
+			"content_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The MIME type of the object. LakeFS defaults to application/octet-stream.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the uploaded bytes, used to detect changes to content or source.",
+			},
+			"checksum": schema.StringAttribute{
+				Computed:    true,
+				Description: "The checksum LakeFS recorded for the object.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The size of the object in bytes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, objectTimeouts),
+		},
+	}
+}
+
+func (r *ObjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan hashes the configured bytes so that edits to content or to the source file show up as a diff.
+func (r *ObjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ObjectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Content.IsUnknown() || plan.Source.IsUnknown() {
+		plan.ContentSha256 = types.StringUnknown()
+	} else {
+		content, err := objectContent(plan)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read Source", err.Error())
+			return
+		}
+		plan.ContentSha256 = types.StringValue(sha256Hex(content))
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state ObjectModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// A new upload changes everything LakeFS computes for the object
+		if !plan.ContentSha256.Equal(state.ContentSha256) || !plan.ContentType.Equal(state.ContentType) {
+			plan.Checksum = types.StringUnknown()
+			plan.SizeBytes = types.Int64Unknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ObjectModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(r.upload(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ObjectModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
+	result, err := statObject(ctx, r.client, data.Repository.ValueString(), data.Branch.ValueString(), data.Path.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read object: %s", err))
+		return
+	}
+
+	// The object was overwritten outside of Terraform, so its bytes no longer match the configuration
+	if result.Checksum != data.Checksum.ValueString() {
+		tflog.Debug(ctx, "Object checksum changed outside of Terraform", map[string]any{
+			"path":     result.Path,
+			"expected": data.Checksum.ValueString(),
+			"actual":   result.Checksum,
+		})
+		data.ContentSha256 = types.StringNull()
+	}
+
+	data.Checksum = types.StringValue(result.Checksum)
+	data.SizeBytes = types.Int64Value(result.SizeBytes)
+	data.ContentType = types.StringValue(result.ContentType)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ObjectModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "update", updateTimeout, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(r.upload(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ObjectModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
+
+	repository := data.Repository.ValueString()
+	branch := data.Branch.ValueString()
+	objectPath := data.Path.ValueString()
+
+	tflog.Debug(ctx, "Deleting object", map[string]any{
+		"repository": repository,
+		"branch":     branch,
+		"path":       objectPath,
+	})
+
+	query := url.Values{"path": {objectPath}}
+	err := r.client.RequestWithQuery(ctx, http.MethodDelete, fmt.Sprintf("/repositories/%s/branches/%s/objects", repository, branch), query, nil, nil)
+	if err != nil {
+		if !IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete object: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted object", map[string]any{"id": data.Id.ValueString()})
+}
+
+func (r *ObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: repository/branch/path - the path may itself contain slashes
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'repository/branch/path', got: %s", req.ID),
+		)
+		return
+	}
+
+	result, err := statObject(ctx, r.client, parts[0], parts[1], parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import object %s: %s", req.ID, err))
+		return
+	}
+
+	var data ObjectModel
+	data.Timeouts = nullTimeouts(ctx, objectTimeouts)
+	data.Id = types.StringValue(req.ID)
+	data.Repository = types.StringValue(parts[0])
+	data.Branch = types.StringValue(parts[1])
+	data.Path = types.StringValue(parts[2])
+	data.Content = types.StringNull()
+	data.Source = types.StringNull()
+	data.ContentType = types.StringValue(result.ContentType)
+	data.ContentSha256 = types.StringNull() // Unknown until the configured bytes are uploaded
+	data.Checksum = types.StringValue(result.Checksum)
+	data.SizeBytes = types.Int64Value(result.SizeBytes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// upload sends the configured bytes to LakeFS and maps the resulting stats into data.
+func (r *ObjectResource) upload(ctx context.Context, data *ObjectModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	repository := data.Repository.ValueString()
+	branch := data.Branch.ValueString()
+	objectPath := data.Path.ValueString()
+
+	content, err := objectContent(*data)
+	if err != nil {
+		diags.AddError("Unable to Read Source", err.Error())
+		return diags
+	}
+
+	tflog.Debug(ctx, "Uploading object", map[string]any{
+		"repository": repository,
+		"branch":     branch,
+		"path":       objectPath,
+		"size":       len(content),
+	})
+
+	result, err := uploadObject(ctx, r.client, repository, branch, objectPath, data.ContentType.ValueString(), content)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to upload object: %s", err))
+		return diags
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", repository, branch, objectPath))
+	data.ContentType = types.StringValue(result.ContentType)
+	data.ContentSha256 = types.StringValue(sha256Hex(content))
+	data.Checksum = types.StringValue(result.Checksum)
+	data.SizeBytes = types.Int64Value(result.SizeBytes)
+
+	tflog.Trace(ctx, "Uploaded object", map[string]any{
+		"id":       data.Id.ValueString(),
+		"checksum": result.Checksum,
+	})
+
+	return diags
+}
+
+// objectContent returns the bytes to upload from either content or the source file.
+func objectContent(data ObjectModel) ([]byte, error) {
+	if !data.Source.IsNull() {
+		content, err := os.ReadFile(data.Source.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("unable to read source file: %w", err)
+		}
+		return content, nil
+	}
+	return []byte(data.Content.ValueString()), nil
+}
+
+// uploadObject uploads content to a branch as a multipart form, as required by the LakeFS objects API.
+func uploadObject(ctx context.Context, client *APIClient, repository, branch, objectPath, contentType string, content []byte) (ObjectStats, error) {
+	// Size the buffer up front so the content is copied into it once
+	var body bytes.Buffer
+	body.Grow(len(content) + 1024)
+	writer := multipart.NewWriter(&body)
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="content"; filename=%q`, objectPath))
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	} else {
+		header.Set("Content-Type", "application/octet-stream")
+	}
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return ObjectStats{}, fmt.Errorf("failed to create multipart body: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return ObjectStats{}, fmt.Errorf("failed to write multipart body: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return ObjectStats{}, fmt.Errorf("failed to write multipart body: %w", err)
+	}
+
+	var result ObjectStats
+	query := url.Values{"path": {objectPath}}
+	err = client.RequestWithBody(ctx, http.MethodPost, fmt.Sprintf("/repositories/%s/branches/%s/objects", repository, branch), query, writer.FormDataContentType(), body.Bytes(), &result)
+	return result, err
+}
+
+// statObject returns the metadata of the object at objectPath on ref.
+func statObject(ctx context.Context, client *APIClient, repository, ref, objectPath string) (ObjectStats, error) {
+	var result ObjectStats
+	query := url.Values{"path": {objectPath}}
+	err := client.GetWithQuery(ctx, fmt.Sprintf("/repositories/%s/refs/%s/objects/stat", repository, ref), query, &result)
+	return result, err
+}
+
+func sha256Hex(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
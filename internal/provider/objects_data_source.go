@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ObjectsDataSource{}
+
+func NewObjectsDataSource() datasource.DataSource {
+	return &ObjectsDataSource{}
+}
+
+// ObjectsDataSource defines the data source implementation.
+type ObjectsDataSource struct {
+	client *APIClient
+}
+
+// ObjectsModel describes the data source data model.
+type ObjectsModel struct {
+	Repository types.String      `tfsdk:"repository"`
+	Ref        types.String      `tfsdk:"ref"`
+	Prefix     types.String      `tfsdk:"prefix"`
+	Delimiter  types.String      `tfsdk:"delimiter"`
+	MaxItems   types.Int64       `tfsdk:"max_items"`
+	Objects    []ObjectItemModel `tfsdk:"objects"`
+}
+
+// ObjectItemModel describes a single object or common prefix in the list.
+type ObjectItemModel struct {
+	Path        types.String `tfsdk:"path"`
+	PathType    types.String `tfsdk:"path_type"`
+	Checksum    types.String `tfsdk:"checksum"`
+	SizeBytes   types.Int64  `tfsdk:"size_bytes"`
+	Mtime       types.Int64  `tfsdk:"mtime"`
+	ContentType types.String `tfsdk:"content_type"`
+}
+
+func (d *ObjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objects"
+}
+
+func (d *ObjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the objects under a prefix of a LakeFS ref.",
+		MarkdownDescription: `Lists the objects under a prefix of a LakeFS ref.
+
+When a delimiter is set, keys that share a prefix up to the delimiter are grouped into a single entry with path_type 'common_prefix', like directories.
+
+## Example Usage
+
+` + "```hcl" + `
+data "lakefs_objects" "partitions" {
+  repository = lakefs_repository.example.id
+  ref        = "main"
+  prefix     = "events/"
+  delimiter  = "/"
+}
+
+output "partitions" {
+  value = [for o in data.lakefs_objects.partitions.objects : o.path if o.path_type == "common_prefix"]
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository to list objects from.",
+			},
+			"ref": schema.StringAttribute{
+				Required:    true,
+				Description: "The branch, tag or commit ID to list objects from.",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return objects whose path starts with this prefix.",
+			},
+			"delimiter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Group paths that share a prefix up to this delimiter into common prefixes.",
+			},
+			"max_items": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Stop after this many entries. By default all pages are fetched.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"objects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The objects and common prefixes matching the filters, in lexicographical order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "The path of the object or common prefix.",
+						},
+						"path_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Either 'object' or 'common_prefix'.",
+						},
+						"checksum": schema.StringAttribute{
+							Computed:    true,
+							Description: "The checksum of the object. Empty for common prefixes.",
+						},
+						"size_bytes": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The size of the object in bytes. Zero for common prefixes.",
+						},
+						"mtime": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Unix epoch timestamp when the object was last modified. Zero for common prefixes.",
+						},
+						"content_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The MIME type of the object. Empty for common prefixes.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ObjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ObjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ObjectsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if prefix := data.Prefix.ValueString(); prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if delimiter := data.Delimiter.ValueString(); delimiter != "" {
+		query.Set("delimiter", delimiter)
+	}
+
+	opts := ListOptions{
+		Query:    query,
+		MaxItems: int(data.MaxItems.ValueInt64()),
+	}
+	path := fmt.Sprintf("/repositories/%s/refs/%s/objects/ls", data.Repository.ValueString(), data.Ref.ValueString())
+
+	data.Objects = []ObjectItemModel{}
+	for object, err := range Paginate[ObjectStats](ctx, d.client, path, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list objects: %s", err))
+			return
+		}
+
+		data.Objects = append(data.Objects, ObjectItemModel{
+			Path:        types.StringValue(object.Path),
+			PathType:    types.StringValue(object.PathType),
+			Checksum:    types.StringValue(object.Checksum),
+			SizeBytes:   types.Int64Value(object.SizeBytes),
+			Mtime:       types.Int64Value(object.Mtime),
+			ContentType: types.StringValue(object.ContentType),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
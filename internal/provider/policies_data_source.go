@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PoliciesDataSource{}
+
+func NewPoliciesDataSource() datasource.DataSource {
+	return &PoliciesDataSource{}
+}
+
+// PoliciesDataSource defines the data source implementation.
+type PoliciesDataSource struct {
+	client *APIClient
+}
+
+// PoliciesModel describes the data source data model.
+type PoliciesModel struct {
+	Prefix   types.String      `tfsdk:"prefix"`
+	Policies []PolicyItemModel `tfsdk:"policies"`
+}
+
+// PolicyItemModel describes a single policy in the list.
+type PolicyItemModel struct {
+	Id           types.String               `tfsdk:"id"`
+	CreationDate types.Int64                `tfsdk:"creation_date"`
+	Statements   []PolicyStatementItemModel `tfsdk:"statements"`
+}
+
+// PolicyStatementItemModel describes a single statement of a listed policy.
+type PolicyStatementItemModel struct {
+	Effect   types.String   `tfsdk:"effect"`
+	Action   []types.String `tfsdk:"action"`
+	Resource types.String   `tfsdk:"resource"`
+}
+
+func (d *PoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies"
+}
+
+func (d *PoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists LakeFS RBAC policies.",
+		MarkdownDescription: `Lists LakeFS RBAC policies.
+
+This data source requires LakeFS Enterprise or LakeFS Cloud.
+
+## Example Usage
+
+` + "```hcl" + `
+data "lakefs_policies" "all" {}
+
+output "policy_ids" {
+  value = data.lakefs_policies.all.policies[*].id
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return policies whose ID starts with this prefix.",
+			},
+			"policies": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The policies matching the filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the policy.",
+						},
+						"creation_date": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Unix epoch timestamp when the policy was created.",
+						},
+						"statements": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The statements of the policy.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"effect": schema.StringAttribute{
+										Computed:    true,
+										Description: "Whether the statement allows or denies the actions.",
+									},
+									"action": schema.ListAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+										Description: "Actions the statement applies to.",
+									},
+									"resource": schema.StringAttribute{
+										Computed:    true,
+										Description: "ARN of the resource the statement applies to.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PoliciesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if prefix := data.Prefix.ValueString(); prefix != "" {
+		query.Set("prefix", prefix)
+	}
+
+	data.Policies = []PolicyItemModel{}
+	for policy, err := range Paginate[PolicyResponse](ctx, d.client, "/auth/policies", ListOptions{Query: query}) {
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list policies: %s", err))
+			return
+		}
+
+		statements := []PolicyStatementItemModel{}
+		for _, s := range policy.Statement {
+			actions := []types.String{}
+			for _, a := range s.Action {
+				actions = append(actions, types.StringValue(a))
+			}
+			statements = append(statements, PolicyStatementItemModel{
+				Effect:   types.StringValue(s.Effect),
+				Action:   actions,
+				Resource: types.StringValue(s.Resource),
+			})
+		}
+
+		data.Policies = append(data.Policies, PolicyItemModel{
+			Id:           types.StringValue(policy.ID),
+			CreationDate: types.Int64Value(policy.CreationDate),
+			Statements:   statements,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,478 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PolicyResource{}
+var _ resource.ResourceWithImportState = &PolicyResource{}
+
+var policyTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Update: true,
+	Delete: true,
+}
+
+func NewPolicyResource() resource.Resource {
+	return &PolicyResource{}
+}
+
+// PolicyResource defines the resource implementation.
+type PolicyResource struct {
+	client *APIClient
+}
+
+// PolicyModel describes the resource data model.
+type PolicyModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Statements   types.List     `tfsdk:"statements"`
+	CreationDate types.Int64    `tfsdk:"creation_date"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// PolicyStatement represents a single statement of a policy
+type PolicyStatement struct {
+	Effect   string   `json:"effect"`
+	Action   []string `json:"action"`
+	Resource string   `json:"resource"`
+}
+
+// PolicyRequest represents the request to create or update a policy
+type PolicyRequest struct {
+	ID        string            `json:"id"`
+	Statement []PolicyStatement `json:"statement"`
+}
+
+// PolicyResponse represents the API response for a policy
+type PolicyResponse struct {
+	ID           string            `json:"id"`
+	CreationDate int64             `json:"creation_date"`
+	Statement    []PolicyStatement `json:"statement"`
+}
+
+func (r *PolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a LakeFS RBAC policy.",
+		MarkdownDescription: `Manages a LakeFS RBAC policy.
+
+A policy is a list of statements, each allowing or denying a set of actions on a resource ARN. Attach policies to users or groups with ` + "`lakefs_user_policy_attachment`" + ` and ` + "`lakefs_group_policy_attachment`" + `. This resource requires LakeFS Enterprise or LakeFS Cloud.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "lakefs_policy" "read_example" {
+  id = "ExampleRepoRead"
+
+  statements = [
+    {
+      effect   = "allow"
+      action   = ["fs:ReadRepository", "fs:ReadObject", "fs:ListObjects"]
+      resource = "arn:lakefs:fs:::repository/example"
+    }
+  ]
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "A unique identifier for the policy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"statements": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "List of policy statements.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"effect": schema.StringAttribute{
+							Required:    true,
+							Description: "Whether the statement allows or denies the actions. One of 'allow' or 'deny'.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("allow", "deny"),
+							},
+						},
+						"action": schema.ListAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "Actions the statement applies to (e.g., 'fs:ReadObject', 'fs:*').",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"resource": schema.StringAttribute{
+							Required:    true,
+							Description: "ARN of the resource the statement applies to (e.g., 'arn:lakefs:fs:::repository/example').",
+						},
+					},
+				},
+			},
+			"creation_date": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Unix epoch timestamp when the policy was created.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, policyTimeouts),
+		},
+	}
+}
+
+func (r *PolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PolicyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
+	statements, diags := extractPolicyStatements(ctx, data.Statements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := PolicyRequest{
+		ID:        data.Id.ValueString(),
+		Statement: statements,
+	}
+
+	tflog.Debug(ctx, "Creating policy", map[string]any{
+		"id":         createReq.ID,
+		"statements": statements,
+	})
+
+	var result PolicyResponse
+	err := r.client.Post(ctx, "/auth/policies", createReq, &result)
+	if err != nil {
+		if IsConflict(err) {
+			resp.Diagnostics.AddError("Policy Already Exists", alreadyExistsDetail("policy", createReq.ID, "lakefs_policy", createReq.ID, err))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create policy: %s", err))
+		return
+	}
+
+	// Statements are kept as planned; the API echoes them back unchanged
+	data.Id = types.StringValue(result.ID)
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	tflog.Trace(ctx, "Created policy", map[string]any{"id": result.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PolicyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
+	var result PolicyResponse
+	err := r.client.Get(ctx, fmt.Sprintf("/auth/policies/%s", data.Id.ValueString()), &result)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy: %s", err))
+		return
+	}
+
+	prior, diags := extractPolicyStatements(ctx, data.Statements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statementsList, diags := policyStatementsToTerraformList(ctx, normalizePolicyStatements(prior, result.Statement))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(result.ID)
+	data.Statements = statementsList
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PolicyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "update", updateTimeout, &resp.Diagnostics)
+
+	policyID := data.Id.ValueString()
+
+	statements, diags := extractPolicyStatements(ctx, data.Statements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := PolicyRequest{
+		ID:        policyID,
+		Statement: statements,
+	}
+
+	tflog.Debug(ctx, "Updating policy", map[string]any{
+		"id":         policyID,
+		"statements": statements,
+	})
+
+	var result PolicyResponse
+	err := r.client.Put(ctx, fmt.Sprintf("/auth/policies/%s", policyID), updateReq, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update policy: %s", err))
+		return
+	}
+
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	tflog.Trace(ctx, "Updated policy", map[string]any{"id": policyID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PolicyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
+
+	policyID := data.Id.ValueString()
+
+	tflog.Debug(ctx, "Deleting policy", map[string]any{"id": policyID})
+
+	err := r.client.Delete(ctx, fmt.Sprintf("/auth/policies/%s", policyID))
+	if err != nil {
+		if !IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete policy: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted policy", map[string]any{"id": policyID})
+}
+
+func (r *PolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var result PolicyResponse
+	err := r.client.Get(ctx, fmt.Sprintf("/auth/policies/%s", req.ID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import policy %s: %s", req.ID, err))
+		return
+	}
+
+	statementsList, diags := policyStatementsToTerraformList(ctx, normalizePolicyStatements(nil, result.Statement))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data PolicyModel
+	data.Timeouts = nullTimeouts(ctx, policyTimeouts)
+	data.Id = types.StringValue(result.ID)
+	data.Statements = statementsList
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// normalizePolicyStatements orders remote statements so that reordering in
+// LakeFS does not produce a diff. Statements and actions that also appear in
+// prior keep their prior position; anything new is appended in sorted order.
+func normalizePolicyStatements(prior, remote []PolicyStatement) []PolicyStatement {
+	remaining := make([]PolicyStatement, len(remote))
+	copy(remaining, remote)
+
+	var normalized []PolicyStatement
+	for _, p := range prior {
+		for i, s := range remaining {
+			if policyStatementKey(s) == policyStatementKey(p) {
+				s.Action = p.Action
+				normalized = append(normalized, s)
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+		}
+	}
+
+	for i := range remaining {
+		actions := make([]string, len(remaining[i].Action))
+		copy(actions, remaining[i].Action)
+		sort.Strings(actions)
+		remaining[i].Action = actions
+	}
+	sort.SliceStable(remaining, func(i, j int) bool {
+		return policyStatementKey(remaining[i]) < policyStatementKey(remaining[j])
+	})
+
+	return append(normalized, remaining...)
+}
+
+// policyStatementKey returns an order-independent key identifying a statement
+func policyStatementKey(s PolicyStatement) string {
+	actions := make([]string, len(s.Action))
+	copy(actions, s.Action)
+	sort.Strings(actions)
+	return s.Resource + "|" + s.Effect + "|" + strings.Join(actions, ",")
+}
+
+// extractPolicyStatements extracts statements from Terraform types
+func extractPolicyStatements(ctx context.Context, statementsList types.List) ([]PolicyStatement, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if statementsList.IsNull() || statementsList.IsUnknown() {
+		return nil, diags
+	}
+
+	var statements []PolicyStatement
+	for _, elem := range statementsList.Elements() {
+		obj := elem.(types.Object)
+		attrs := obj.Attributes()
+
+		var actions []string
+		diags.Append(attrs["action"].(types.List).ElementsAs(ctx, &actions, false)...)
+
+		statements = append(statements, PolicyStatement{
+			Effect:   attrs["effect"].(types.String).ValueString(),
+			Action:   actions,
+			Resource: attrs["resource"].(types.String).ValueString(),
+		})
+	}
+
+	return statements, diags
+}
+
+// policyStatementsToTerraformList converts statements to Terraform types.List
+func policyStatementsToTerraformList(ctx context.Context, statements []PolicyStatement) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	statementAttrTypes := map[string]attr.Type{
+		"effect":   types.StringType,
+		"action":   types.ListType{ElemType: types.StringType},
+		"resource": types.StringType,
+	}
+
+	var statementValues []attr.Value
+	for _, statement := range statements {
+		actions, d := types.ListValueFrom(ctx, types.StringType, statement.Action)
+		diags.Append(d...)
+
+		statementObj, d := types.ObjectValue(
+			statementAttrTypes,
+			map[string]attr.Value{
+				"effect":   types.StringValue(statement.Effect),
+				"action":   actions,
+				"resource": types.StringValue(statement.Resource),
+			},
+		)
+		diags.Append(d...)
+		statementValues = append(statementValues, statementObj)
+	}
+
+	statementsList, d := types.ListValue(
+		types.ObjectType{AttrTypes: statementAttrTypes},
+		statementValues,
+	)
+	diags.Append(d...)
+
+	return statementsList, diags
+}
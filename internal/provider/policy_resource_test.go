@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizePolicyStatements(t *testing.T) {
+	read := PolicyStatement{Effect: "allow", Resource: "*", Action: []string{"fs:ReadObject", "fs:ListObjects"}}
+	write := PolicyStatement{Effect: "allow", Resource: "arn:lakefs:fs:::repository/data/*", Action: []string{"fs:WriteObject"}}
+	deny := PolicyStatement{Effect: "deny", Resource: "*", Action: []string{"fs:DeleteRepository"}}
+
+	tests := map[string]struct {
+		prior  []PolicyStatement
+		remote []PolicyStatement
+		want   []PolicyStatement
+	}{
+		"statements reordered by the server keep the prior order": {
+			prior:  []PolicyStatement{write, read},
+			remote: []PolicyStatement{read, write},
+			want:   []PolicyStatement{write, read},
+		},
+		"actions reordered by the server keep the prior order": {
+			prior: []PolicyStatement{read},
+			remote: []PolicyStatement{
+				{Effect: "allow", Resource: "*", Action: []string{"fs:ListObjects", "fs:ReadObject"}},
+			},
+			want: []PolicyStatement{read},
+		},
+		"new statements are appended sorted with sorted actions": {
+			prior: []PolicyStatement{write},
+			remote: []PolicyStatement{
+				read,
+				write,
+				deny,
+			},
+			want: []PolicyStatement{
+				write,
+				{Effect: "allow", Resource: "*", Action: []string{"fs:ListObjects", "fs:ReadObject"}},
+				deny,
+			},
+		},
+		"statements removed on the server are dropped": {
+			prior:  []PolicyStatement{read, write, deny},
+			remote: []PolicyStatement{deny, read},
+			want:   []PolicyStatement{read, deny},
+		},
+		"no prior state, as on import": {
+			remote: []PolicyStatement{write, deny},
+			want:   []PolicyStatement{deny, write},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			remote := make([]PolicyStatement, len(tt.remote))
+			copy(remote, tt.remote)
+
+			got := normalizePolicyStatements(tt.prior, remote)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizePolicyStatements() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(remote, tt.remote) {
+				t.Errorf("normalizePolicyStatements() modified its input: %v, want %v", remote, tt.remote)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PresignedURLDataSource{}
+
+func NewPresignedURLDataSource() datasource.DataSource {
+	return &PresignedURLDataSource{}
+}
+
+// PresignedURLDataSource defines the data source implementation.
+type PresignedURLDataSource struct {
+	client *APIClient
+}
+
+// PresignedURLModel describes the data source and ephemeral resource data model.
+type PresignedURLModel struct {
+	Repository types.String `tfsdk:"repository"`
+	Ref        types.String `tfsdk:"ref"`
+	Path       types.String `tfsdk:"path"`
+	URL        types.String `tfsdk:"url"`
+	Expiry     types.Int64  `tfsdk:"expiry"`
+}
+
+func (d *PresignedURLDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_presigned_url"
+}
+
+func (d *PresignedURLDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a time-limited presigned URL for reading a LakeFS object directly from the underlying storage.",
+		MarkdownDescription: `Generates a time-limited presigned URL for reading a LakeFS object directly from the underlying storage.
+
+The URL is stored in the Terraform state and a new one is generated on every refresh. Use the ` + "`lakefs_presigned_url`" + ` ephemeral resource to keep it out of the state. The LakeFS server must have presigned URL support enabled for its blockstore.
+
+## Example Usage
+
+` + "```hcl" + `
+data "lakefs_presigned_url" "report" {
+  repository = lakefs_repository.example.id
+  ref        = "main"
+  path       = "reports/daily.parquet"
+}
+
+output "report_url" {
+  value     = data.lakefs_presigned_url.report.url
+  sensitive = true
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository containing the object.",
+			},
+			"ref": schema.StringAttribute{
+				Required:    true,
+				Description: "The branch, tag or commit ID to read the object from.",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the object.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The presigned URL. Anyone holding it can read the object until it expires.",
+			},
+			"expiry": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Unix epoch timestamp when the URL expires.",
+			},
+		},
+	}
+}
+
+func (d *PresignedURLDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PresignedURLDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PresignedURLModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := presignObject(ctx, d.client, data.Repository.ValueString(), data.Ref.ValueString(), data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to presign object: %s", err))
+		return
+	}
+
+	data.URL = types.StringValue(result.PhysicalAddress)
+	data.Expiry = types.Int64Value(result.PhysicalAddressExpiry)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// presignObject stats an object with presign=true, so that its physical address is a presigned URL.
+func presignObject(ctx context.Context, client *APIClient, repository, ref, objectPath string) (ObjectStats, error) {
+	tflog.Debug(ctx, "Presigning object", map[string]any{
+		"repository": repository,
+		"ref":        ref,
+		"path":       objectPath,
+	})
+
+	var result ObjectStats
+	query := url.Values{
+		"path":    {objectPath},
+		"presign": {"true"},
+	}
+	err := client.GetWithQuery(ctx, fmt.Sprintf("/repositories/%s/refs/%s/objects/stat", repository, ref), query, &result)
+	if err != nil {
+		return result, err
+	}
+
+	// Servers whose blockstore cannot presign fall back to the raw storage address
+	if result.PhysicalAddressExpiry == 0 {
+		return result, fmt.Errorf("the LakeFS server did not return a presigned URL for %s; check that presigned URL support is enabled for the blockstore", objectPath)
+	}
+
+	return result, nil
+}
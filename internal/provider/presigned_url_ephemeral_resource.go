@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &PresignedURLEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &PresignedURLEphemeralResource{}
+
+func NewPresignedURLEphemeralResource() ephemeral.EphemeralResource {
+	return &PresignedURLEphemeralResource{}
+}
+
+// PresignedURLEphemeralResource defines the ephemeral resource implementation.
+type PresignedURLEphemeralResource struct {
+	client *APIClient
+}
+
+func (e *PresignedURLEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_presigned_url"
+}
+
+func (e *PresignedURLEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a time-limited presigned URL for a LakeFS object that is never persisted to the Terraform plan or state.",
+		MarkdownDescription: `Generates a time-limited presigned URL for a LakeFS object that is never persisted to the Terraform plan or state.
+
+The LakeFS server must have presigned URL support enabled for its blockstore.
+
+## Example Usage
+
+` + "```hcl" + `
+ephemeral "lakefs_presigned_url" "export" {
+  repository = lakefs_repository.example.id
+  ref        = "v1.0.0"
+  path       = "exports/customers.csv"
+}
+
+resource "example_sftp_transfer" "partner" {
+  source_url_wo = ephemeral.lakefs_presigned_url.export.url
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository containing the object.",
+			},
+			"ref": schema.StringAttribute{
+				Required:    true,
+				Description: "The branch, tag or commit ID to read the object from.",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the object.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The presigned URL. Anyone holding it can read the object until it expires.",
+			},
+			"expiry": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Unix epoch timestamp when the URL expires.",
+			},
+		},
+	}
+}
+
+func (e *PresignedURLEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+func (e *PresignedURLEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data PresignedURLModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := presignObject(ctx, e.client, data.Repository.ValueString(), data.Ref.ValueString(), data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to presign object: %s", err))
+		return
+	}
+
+	data.URL = types.StringValue(result.PhysicalAddress)
+	data.Expiry = types.Int64Value(result.PhysicalAddressExpiry)
+
+	tflog.Trace(ctx, "Presigned object", map[string]any{
+		"path":       data.Path.ValueString(),
+		"expires_at": time.Unix(result.PhysicalAddressExpiry, 0).UTC().Format(time.RFC3339),
+	})
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -5,19 +5,26 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure LakeFSProvider satisfies various provider interfaces.
 var _ provider.Provider = &LakeFSProvider{}
+var _ provider.ProviderWithEphemeralResources = &LakeFSProvider{}
 
 // LakeFSProvider defines the provider implementation.
 type LakeFSProvider struct {
@@ -29,18 +36,38 @@ type LakeFSProvider struct {
 
 // LakeFSProviderModel describes the provider data model.
 type LakeFSProviderModel struct {
-	Endpoint        types.String `tfsdk:"endpoint"`
-	AccessKeyID     types.String `tfsdk:"access_key_id"`
-	SecretAccessKey types.String `tfsdk:"secret_access_key"`
-	SkipSSLVerify   types.Bool   `tfsdk:"skip_ssl_verify"`
+	Endpoint              types.String  `tfsdk:"endpoint"`
+	AccessKeyID           types.String  `tfsdk:"access_key_id"`
+	SecretAccessKey       types.String  `tfsdk:"secret_access_key"`
+	SkipSSLVerify         types.Bool    `tfsdk:"skip_ssl_verify"`
+	RequestTimeout        types.String  `tfsdk:"request_timeout"`
+	MaxRetries            types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait          types.String  `tfsdk:"retry_max_wait"`
+	MaxIdleConnections    types.Int64   `tfsdk:"max_idle_connections"`
+	MaxConnections        types.Int64   `tfsdk:"max_connections"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
 }
 
+// defaultMaxRetries is the number of retries used when max_retries is not set
+const defaultMaxRetries = 3
+
 // LakeFSClient holds the configuration for connecting to LakeFS
 type LakeFSClient struct {
 	Endpoint        string
 	AccessKeyID     string
 	SecretAccessKey string
 	SkipSSLVerify   bool
+
+	// Client tuning. A zero RequestTimeout, RetryMaxWait or MaxIdleConnsPerHost selects the
+	// client default; a zero value for any other option disables it.
+	RequestTimeout        time.Duration
+	MaxRetries            int
+	RetryMaxWait          time.Duration
+	MaxIdleConnsPerHost   int
+	MaxConnsPerHost       int
+	MaxConcurrentRequests int
+	RequestsPerSecond     float64
 }
 
 func (p *LakeFSProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -95,6 +122,62 @@ credentials in the provider block or via environment variables:
 				Description: "Skip SSL certificate verification. Default is false.",
 				Optional:    true,
 			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Maximum time a single API request may take, as a duration such as '30s' or '2m'. " +
+					"A request that times out is retried if it is safe to repeat. " +
+					"To bound a whole operation instead, use the timeouts block of a resource. Default is '30s'.",
+				Optional: true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a request is retried after a 429, 502, 503 or 504 response or a connection error. " +
+					"Only requests that are safe to repeat are retried. Set to 0 to disable retries. Default is 3.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Description: "Maximum time to wait between retries, as a duration such as '30s' or '2m'. " +
+					"Also caps any Retry-After sent by the server. Default is '30s'.",
+				Optional: true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"max_idle_connections": schema.Int64Attribute{
+				Description: "Maximum number of idle connections to LakeFS kept open for reuse between requests. Default is 10.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_connections": schema.Int64Attribute{
+				Description: "Maximum number of open connections to LakeFS, including those in use. " +
+					"Requests beyond the limit wait for a free connection. Default is no limit.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of API requests in flight at once across all resources, regardless of Terraform's -parallelism. " +
+					"Requests beyond the limit wait for one to finish. Default is no limit.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum rate at which API requests are started across all resources, e.g. 5 or 0.5. " +
+					"Short bursts of up to one second's worth of requests are allowed. Default is no limit.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.1),
+				},
+			},
 		},
 	}
 }
@@ -113,6 +196,9 @@ func (p *LakeFSProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	accessKeyID := os.Getenv("LAKEFS_ACCESS_KEY_ID")
 	secretAccessKey := os.Getenv("LAKEFS_SECRET_ACCESS_KEY")
 	skipSSLVerify := false
+	requestTimeout := configuredDuration(config.RequestTimeout, defaultRequestTimeout)
+	maxRetries := defaultMaxRetries
+	retryMaxWait := configuredDuration(config.RetryMaxWait, defaultRetryMaxWait)
 
 	// Override with provider configuration if set
 	if !config.Endpoint.IsNull() {
@@ -127,6 +213,9 @@ func (p *LakeFSProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	if !config.SkipSSLVerify.IsNull() {
 		skipSSLVerify = config.SkipSSLVerify.ValueBool()
 	}
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
 
 	// Validate required configuration
 	if endpoint == "" {
@@ -161,20 +250,36 @@ func (p *LakeFSProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	// Create client configuration
-	client := &LakeFSClient{
-		Endpoint:        endpoint,
-		AccessKeyID:     accessKeyID,
-		SecretAccessKey: secretAccessKey,
-		SkipSSLVerify:   skipSSLVerify,
+	clientConfig := &LakeFSClient{
+		Endpoint:              endpoint,
+		AccessKeyID:           accessKeyID,
+		SecretAccessKey:       secretAccessKey,
+		SkipSSLVerify:         skipSSLVerify,
+		RequestTimeout:        requestTimeout,
+		MaxRetries:            maxRetries,
+		RetryMaxWait:          retryMaxWait,
+		MaxIdleConnsPerHost:   int(config.MaxIdleConnections.ValueInt64()),
+		MaxConnsPerHost:       int(config.MaxConnections.ValueInt64()),
+		MaxConcurrentRequests: int(config.MaxConcurrentRequests.ValueInt64()),
+		RequestsPerSecond:     config.RequestsPerSecond.ValueFloat64(),
 	}
 
+	// A single client is shared by all resources and data sources so connections are reused
+	client := NewAPIClient(clientConfig)
+
 	tflog.Debug(ctx, "Created LakeFS client", map[string]any{
-		"endpoint": endpoint,
+		"endpoint":                endpoint,
+		"request_timeout":         requestTimeout.String(),
+		"max_retries":             maxRetries,
+		"retry_max_wait":          retryMaxWait.String(),
+		"max_concurrent_requests": clientConfig.MaxConcurrentRequests,
+		"requests_per_second":     clientConfig.RequestsPerSecond,
 	})
 
 	// Make the client available to resources and data sources
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *LakeFSProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -183,15 +288,45 @@ func (p *LakeFSProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewBranchResource,
 		NewTagResource,
 		NewBranchProtectionResource,
+		NewGCRulesResource,
+		NewUserResource,
+		NewGroupResource,
+		NewGroupMembershipResource,
+		NewPolicyResource,
+		NewUserPolicyAttachmentResource,
+		NewGroupPolicyAttachmentResource,
+		NewCredentialsResource,
+		NewCommitResource,
+		NewMergeResource,
+		NewObjectResource,
+		NewActionResource,
+	}
+}
+
+func (p *LakeFSProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewLoginTokenEphemeralResource,
+		NewPresignedURLEphemeralResource,
 	}
 }
 
 func (p *LakeFSProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewRepositoryDataSource,
+		NewRepositoriesDataSource,
 		NewBranchDataSource,
+		NewBranchesDataSource,
+		NewTagsDataSource,
 		NewCommitDataSource,
+		NewObjectDataSource,
+		NewObjectsDataSource,
+		NewPresignedURLDataSource,
+		NewActionRunsDataSource,
+		NewActionRunDataSource,
 		NewCurrentUserDataSource,
+		NewUsersDataSource,
+		NewGroupsDataSource,
+		NewPoliciesDataSource,
 	}
 }
 
@@ -202,3 +337,43 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// configuredDuration returns the duration set in value, or fallback when it is not set.
+// Invalid values have already been rejected by durationValidator during validation.
+func configuredDuration(value types.String, fallback time.Duration) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return fallback
+	}
+	return duration
+}
+
+// durationValidator checks that a string is a positive Go duration such as "30s" or "10m"
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as \"30s\" or \"10m\""
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The value %q is not a valid duration: %s.", value, v.Description(ctx)),
+		)
+	}
+}
@@ -6,12 +6,18 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -22,6 +28,13 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"lakefs": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccProtoV6ProviderFactoriesWithEcho includes the echo provider alongside
+// the LakeFS provider, so ephemeral resource results can be inspected in state.
+var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"lakefs": providerserver.NewProtocol6WithError(New("test")()),
+	"echo":   echoprovider.NewProviderServer(),
+}
+
 func testAccPreCheck(t *testing.T) {
 	// Check for required environment variables
 	if v := os.Getenv("LAKEFS_ENDPOINT"); v == "" {
@@ -35,6 +48,23 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccPreCheckEnterprise skips tests for resources that are only available
+// in LakeFS Enterprise or LakeFS Cloud, such as users, groups and policies.
+func testAccPreCheckEnterprise(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("LAKEFS_ENTERPRISE"); v == "" {
+		t.Skip("LAKEFS_ENTERPRISE must be set for RBAC acceptance tests")
+	}
+}
+
+// testAccPreCheckPresign skips tests that need a blockstore with presigned URL support.
+func testAccPreCheckPresign(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("LAKEFS_PRESIGN"); v == "" {
+		t.Skip("LAKEFS_PRESIGN must be set for presigned URL acceptance tests")
+	}
+}
+
 func TestAccRepositoryResource(t *testing.T) {
 	// Use unique name with timestamp to avoid conflicts with previous test runs
 	repoName := fmt.Sprintf("testrepo%d", time.Now().UnixNano())
@@ -109,6 +139,64 @@ resource "lakefs_branch" "test" {
 `, repoName, branchName)
 }
 
+func TestAccBranchResource_alreadyExists(t *testing.T) {
+	repoName := fmt.Sprintf("branchtestrepo%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The repository's default branch is created with it, so creating it again conflicts
+				Config:      testAccBranchResourceConfig(repoName, "main"),
+				ExpectError: regexp.MustCompile(`Branch Already Exists`),
+			},
+		},
+	})
+}
+
+func TestAccBranchResource_timeouts(t *testing.T) {
+	repoName := fmt.Sprintf("branchtestrepo%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchResourceConfigTimeouts(repoName, "5m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_branch.test", "timeouts.create", "5m"),
+					resource.TestCheckResourceAttrSet("lakefs_branch.test", "commit_id"),
+				),
+			},
+			{
+				Config:      testAccBranchResourceConfigTimeouts(repoName, "soon"),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Time Duration`),
+			},
+		},
+	})
+}
+
+func testAccBranchResourceConfigTimeouts(repoName, create string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_branch" "test" {
+  repository = lakefs_repository.test.id
+  name       = "testbranch"
+  source     = "main"
+
+  timeouts {
+    create = %[2]q
+  }
+}
+`, repoName, create)
+}
+
 func TestAccCurrentUserDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -188,6 +276,47 @@ func TestAccBranchProtectionResource(t *testing.T) {
 	})
 }
 
+func TestAccBranchProtectionResource_blockedActions(t *testing.T) {
+	repoName := fmt.Sprintf("bptestrepo%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchProtectionResourceConfigBlockedActions(repoName, `["commit", "staging_write"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_branch_protection.test", "rules.0.blocked_actions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("lakefs_branch_protection.test", "rules.0.blocked_actions.*", "commit"),
+					resource.TestCheckTypeSetElemAttr("lakefs_branch_protection.test", "rules.0.blocked_actions.*", "staging_write"),
+				),
+			},
+			// Update testing
+			{
+				Config: testAccBranchProtectionResourceConfigBlockedActions(repoName, `["delete"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_branch_protection.test", "rules.0.blocked_actions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("lakefs_branch_protection.test", "rules.0.blocked_actions.*", "delete"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBranchProtectionResource_invalidBlockedAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBranchProtectionResourceConfigBlockedActions("invalidrepo", `["push"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
 func testAccBranchProtectionResourceConfig(repoName string) string {
 	return fmt.Sprintf(`
 resource "lakefs_repository" "test" {
@@ -205,29 +334,74 @@ resource "lakefs_branch_protection" "test" {
 `, repoName)
 }
 
+func testAccBranchProtectionResourceConfigBlockedActions(repoName, blockedActions string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_branch_protection" "test" {
+  repository = lakefs_repository.test.id
+  rules = [
+    { pattern = "main", blocked_actions = %[2]s }
+  ]
+}
+`, repoName, blockedActions)
+}
+
 // =====================
-// Repository Data Source Tests
+// GC Rules Resource Tests
 // =====================
 
-func TestAccRepositoryDataSource(t *testing.T) {
-	repoName := fmt.Sprintf("dsrepo%d", time.Now().UnixNano())
+func TestAccGCRulesResource(t *testing.T) {
+	repoName := fmt.Sprintf("gctestrepo%d", time.Now().UnixNano())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRepositoryDataSourceConfig(repoName),
+				Config: testAccGCRulesResourceConfig(repoName, 14, 90),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.lakefs_repository.test", "default_branch", "main"),
-					resource.TestCheckResourceAttrSet("data.lakefs_repository.test", "storage_namespace"),
+					resource.TestCheckResourceAttr("lakefs_gc_rules.test", "id", repoName),
+					resource.TestCheckResourceAttr("lakefs_gc_rules.test", "default_retention_days", "14"),
+					resource.TestCheckResourceAttr("lakefs_gc_rules.test", "branches.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("lakefs_gc_rules.test", "branches.*", map[string]string{
+						"branch":         "main",
+						"retention_days": "90",
+					}),
+				),
+			},
+			// Update testing
+			{
+				Config: testAccGCRulesResourceConfig(repoName, 7, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_gc_rules.test", "default_retention_days", "7"),
+					resource.TestCheckTypeSetElemNestedAttrs("lakefs_gc_rules.test", "branches.*", map[string]string{
+						"branch":         "main",
+						"retention_days": "30",
+					}),
 				),
 			},
+			// ImportState testing
+			{
+				ResourceName:      "lakefs_gc_rules.test",
+				ImportState:       true,
+				ImportStateId:     repoName,
+				ImportStateVerify: true,
+			},
+			// The same branch cannot have two overrides
+			{
+				Config:      testAccGCRulesResourceConfigDuplicate(repoName),
+				ExpectError: regexp.MustCompile(`Duplicate Branch`),
+			},
 		},
 	})
 }
 
-func testAccRepositoryDataSourceConfig(repoName string) string {
+func testAccGCRulesResourceConfig(repoName string, defaultDays, mainDays int) string {
 	return fmt.Sprintf(`
 resource "lakefs_repository" "test" {
   name              = %[1]q
@@ -235,35 +409,71 @@ resource "lakefs_repository" "test" {
   default_branch    = "main"
 }
 
-data "lakefs_repository" "test" {
-  repository = lakefs_repository.test.id
+resource "lakefs_gc_rules" "test" {
+  repository             = lakefs_repository.test.id
+  default_retention_days = %[2]d
+
+  branches = [
+    { branch = "main", retention_days = %[3]d }
+  ]
+}
+`, repoName, defaultDays, mainDays)
+}
+
+func testAccGCRulesResourceConfigDuplicate(repoName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_gc_rules" "test" {
+  repository             = lakefs_repository.test.id
+  default_retention_days = 7
+
+  branches = [
+    { branch = "main", retention_days = 30 },
+    { branch = "main", retention_days = 90 }
+  ]
 }
 `, repoName)
 }
 
 // =====================
-// Branch Data Source Tests
+// Commit Resource Tests
 // =====================
 
-func TestAccBranchDataSource(t *testing.T) {
-	repoName := fmt.Sprintf("dsbranch%d", time.Now().UnixNano())
+func TestAccCommitResource(t *testing.T) {
+	repoName := fmt.Sprintf("committestrepo%d", time.Now().UnixNano())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBranchDataSourceConfig(repoName),
+				Config: testAccCommitResourceConfig(repoName, "Seed reference data"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.lakefs_branch.test", "branch", "main"),
-					resource.TestCheckResourceAttrSet("data.lakefs_branch.test", "commit_id"),
+					resource.TestCheckResourceAttr("lakefs_commit.test", "message", "Seed reference data"),
+					resource.TestCheckResourceAttr("lakefs_commit.test", "metadata.source", "terraform"),
+					resource.TestCheckResourceAttr("lakefs_commit.test", "creation_date", "1700000000"),
+					resource.TestCheckResourceAttr("lakefs_commit.test", "parents.#", "1"),
+					resource.TestCheckResourceAttrSet("lakefs_commit.test", "commit_id"),
+					resource.TestCheckResourceAttrSet("lakefs_commit.test", "committer"),
+				),
+			},
+			// Changing the message creates a new commit
+			{
+				Config: testAccCommitResourceConfig(repoName, "Refresh reference data"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_commit.test", "message", "Refresh reference data"),
 				),
 			},
 		},
 	})
 }
 
-func testAccBranchDataSourceConfig(repoName string) string {
+func testAccCommitResourceConfig(repoName, message string) string {
 	return fmt.Sprintf(`
 resource "lakefs_repository" "test" {
   name              = %[1]q
@@ -271,36 +481,120 @@ resource "lakefs_repository" "test" {
   default_branch    = "main"
 }
 
-data "lakefs_branch" "test" {
+resource "lakefs_commit" "test" {
+  repository  = lakefs_repository.test.id
+  branch      = "main"
+  message     = %[2]q
+  date        = 1700000000
+  allow_empty = true
+
+  metadata = {
+    source = "terraform"
+  }
+}
+`, repoName, message)
+}
+
+// =====================
+// Merge Resource Tests
+// =====================
+
+func TestAccMergeResource(t *testing.T) {
+	repoName := fmt.Sprintf("mergetestrepo%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMergeResourceConfig(repoName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_merge.test", "source_ref", "dev"),
+					resource.TestCheckResourceAttr("lakefs_merge.test", "destination_branch", "main"),
+					resource.TestCheckResourceAttr("lakefs_merge.test", "strategy", "source-wins"),
+					resource.TestCheckResourceAttrSet("lakefs_merge.test", "merge_commit_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMergeResourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_branch" "dev" {
   repository = lakefs_repository.test.id
-  branch     = "main"
+  name       = "dev"
+  source     = "main"
+}
+
+resource "lakefs_commit" "dev" {
+  repository  = lakefs_repository.test.id
+  branch      = lakefs_branch.dev.name
+  message     = "Change on dev"
+  allow_empty = true
+}
+
+resource "lakefs_merge" "test" {
+  repository         = lakefs_repository.test.id
+  source_ref         = lakefs_branch.dev.name
+  destination_branch = "main"
+  message            = "Promote dev to main"
+  strategy           = "source-wins"
+
+  metadata = {
+    promoted_commit = lakefs_commit.dev.commit_id
+  }
 }
 `, repoName)
 }
 
 // =====================
-// Commit Data Source Tests
+// Object Resource Tests
 // =====================
 
-func TestAccCommitDataSource(t *testing.T) {
-	repoName := fmt.Sprintf("dscommit%d", time.Now().UnixNano())
+func TestAccObjectResource(t *testing.T) {
+	repoName := fmt.Sprintf("objtestrepo%d", time.Now().UnixNano())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCommitDataSourceConfig(repoName),
+				Config: testAccObjectResourceConfig(repoName, "hello"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("data.lakefs_commit.test", "id"),
-					resource.TestCheckResourceAttrSet("data.lakefs_commit.test", "creation_date"),
+					resource.TestCheckResourceAttr("lakefs_object.test", "id", repoName+"/main/config/settings.json"),
+					resource.TestCheckResourceAttr("lakefs_object.test", "size_bytes", "5"),
+					resource.TestCheckResourceAttr("lakefs_object.test", "content_type", "application/json"),
+					resource.TestCheckResourceAttrSet("lakefs_object.test", "checksum"),
+					resource.TestCheckResourceAttrSet("lakefs_object.test", "content_sha256"),
+				),
+			},
+			// Changing the content uploads the object again
+			{
+				Config: testAccObjectResourceConfig(repoName, "hello, world"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_object.test", "size_bytes", "12"),
 				),
 			},
+			// ImportState testing
+			{
+				ResourceName:            "lakefs_object.test",
+				ImportState:             true,
+				ImportStateId:           repoName + "/main/config/settings.json",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "content_sha256"},
+			},
 		},
 	})
 }
 
-func testAccCommitDataSourceConfig(repoName string) string {
+func testAccObjectResourceConfig(repoName, content string) string {
 	return fmt.Sprintf(`
 resource "lakefs_repository" "test" {
   name              = %[1]q
@@ -308,14 +602,1033 @@ resource "lakefs_repository" "test" {
   default_branch    = "main"
 }
 
-data "lakefs_branch" "main" {
+resource "lakefs_object" "test" {
+  repository   = lakefs_repository.test.id
+  branch       = "main"
+  path         = "config/settings.json"
+  content      = %[2]q
+  content_type = "application/json"
+}
+`, repoName, content)
+}
+
+// =====================
+// Action Resource Tests
+// =====================
+
+func TestAccActionResource(t *testing.T) {
+	repoName := fmt.Sprintf("actiontestrepo%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActionResourceConfig(repoName, "1m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_action.test", "file_name", "pre_merge_checks.yaml"),
+					resource.TestCheckResourceAttr("lakefs_action.test", "path", "_lakefs_actions/pre_merge_checks.yaml"),
+					resource.TestCheckResourceAttr("lakefs_action.test", "on.pre-merge.branches.0", "main"),
+					resource.TestCheckResourceAttr("lakefs_action.test", "hooks.#", "2"),
+					resource.TestCheckResourceAttr("lakefs_action.test", "hooks.0.properties.timeout", "1m"),
+					resource.TestCheckResourceAttrSet("lakefs_action.test", "commit_id"),
+					resource.TestCheckResourceAttrSet("lakefs_action.test", "content"),
+				),
+			},
+			// Changing a hook property rewrites and commits the file; the new YAML is known at plan time
+			{
+				Config: testAccActionResourceConfig(repoName, "2m"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("lakefs_action.test", tfjsonpath.New("content"), knownvalue.StringRegexp(regexp.MustCompile(`timeout: 2m`))),
+						plancheck.ExpectUnknownValue("lakefs_action.test", tfjsonpath.New("commit_id")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_action.test", "hooks.0.properties.timeout", "2m"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "lakefs_action.test",
+				ImportState:       true,
+				ImportStateId:     repoName + "/main/pre_merge_checks.yaml",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccActionResourceConfig(repoName, timeout string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_action" "test" {
   repository = lakefs_repository.test.id
   branch     = "main"
+  name       = "pre merge checks"
+
+  on = {
+    "pre-merge" = {
+      branches = ["main"]
+    }
+  }
+
+  hooks = [
+    {
+      id   = "format_validator"
+      type = "webhook"
+      properties = {
+        url     = "http://localhost:9999/validate"
+        timeout = %[2]q
+        query_params = jsonencode({
+          prefix = ["tables/"]
+        })
+      }
+    },
+    {
+      id          = "schema_check"
+      type        = "lua"
+      description = "Checks table schemas"
+      properties = {
+        script = "print(\"ok\")"
+      }
+    }
+  ]
+}
+`, repoName, timeout)
 }
 
-data "lakefs_commit" "test" {
-  repository = lakefs_repository.test.id
-  commit_id  = data.lakefs_branch.main.commit_id
+func TestAccActionResource_fileName(t *testing.T) {
+	repoName := fmt.Sprintf("actiontestrepo%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Path characters in the name are replaced in the default file name
+			{
+				Config: testAccActionResourceConfigFileName(repoName, "team/../checks", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_action.test", "file_name", "team_.._checks.yaml"),
+					resource.TestCheckResourceAttr("lakefs_action.test", "path", "_lakefs_actions/team_.._checks.yaml"),
+				),
+			},
+			{
+				Config:      testAccActionResourceConfigFileName(repoName, "checks", "nested/checks.yaml"),
+				ExpectError: regexp.MustCompile(`must only contain letters, digits`),
+			},
+		},
+	})
 }
-`, repoName)
+
+func testAccActionResourceConfigFileName(repoName, name, fileName string) string {
+	fileNameAttr := ""
+	if fileName != "" {
+		fileNameAttr = fmt.Sprintf("file_name  = %q", fileName)
+	}
+
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_action" "test" {
+  repository = lakefs_repository.test.id
+  branch     = "main"
+  name       = %[2]q
+  %[3]s
+
+  on = {
+    "pre-commit" = {}
+  }
+
+  hooks = [
+    {
+      id   = "check"
+      type = "lua"
+      properties = {
+        script = "print(\"ok\")"
+      }
+    }
+  ]
+}
+`, repoName, name, fileNameAttr)
+}
+
+// =====================
+// Repository Data Source Tests
+// =====================
+
+func TestAccRepositoryDataSource(t *testing.T) {
+	repoName := fmt.Sprintf("dsrepo%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryDataSourceConfig(repoName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_repository.test", "default_branch", "main"),
+					resource.TestCheckResourceAttrSet("data.lakefs_repository.test", "storage_namespace"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+data "lakefs_repository" "test" {
+  repository = lakefs_repository.test.id
+}
+`, repoName)
+}
+
+// =====================
+// Repositories Data Source Tests
+// =====================
+
+func TestAccRepositoriesDataSource(t *testing.T) {
+	prefix := fmt.Sprintf("dsrepos%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoriesDataSourceConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_repositories.test", "repositories.#", "2"),
+					resource.TestCheckResourceAttr("data.lakefs_repositories.test", "repositories.0.id", prefix+"-a"),
+					resource.TestCheckResourceAttr("data.lakefs_repositories.test", "repositories.0.default_branch", "main"),
+					resource.TestCheckResourceAttr("data.lakefs_repositories.test", "repositories.0.read_only", "false"),
+					resource.TestCheckResourceAttrSet("data.lakefs_repositories.test", "repositories.1.storage_namespace"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoriesDataSourceConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "a" {
+  name              = "%[1]s-a"
+  storage_namespace = "s3://lakefs-data/%[1]s-a"
+  default_branch    = "main"
+}
+
+resource "lakefs_repository" "b" {
+  name              = "%[1]s-b"
+  storage_namespace = "s3://lakefs-data/%[1]s-b"
+  default_branch    = "main"
+}
+
+data "lakefs_repositories" "test" {
+  prefix = %[1]q
+
+  depends_on = [lakefs_repository.a, lakefs_repository.b]
+}
+`, prefix)
+}
+
+// =====================
+// Branch Data Source Tests
+// =====================
+
+func TestAccBranchDataSource(t *testing.T) {
+	repoName := fmt.Sprintf("dsbranch%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchDataSourceConfig(repoName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_branch.test", "branch", "main"),
+					resource.TestCheckResourceAttrSet("data.lakefs_branch.test", "commit_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBranchDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+data "lakefs_branch" "test" {
+  repository = lakefs_repository.test.id
+  branch     = "main"
+}
+`, repoName)
+}
+
+// =====================
+// Branches and Tags Data Source Tests
+// =====================
+
+func TestAccBranchesAndTagsDataSources(t *testing.T) {
+	repoName := fmt.Sprintf("dsrefs%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchesAndTagsDataSourcesConfig(repoName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_branches.features", "branches.#", "2"),
+					resource.TestCheckResourceAttr("data.lakefs_branches.features", "branches.0.id", "feature-a"),
+					resource.TestCheckResourceAttrSet("data.lakefs_branches.features", "branches.0.commit_id"),
+					resource.TestCheckResourceAttr("data.lakefs_branches.limited", "branches.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_tags.releases", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_tags.releases", "tags.0.id", "v1.0.0"),
+					resource.TestCheckResourceAttrPair("data.lakefs_tags.releases", "tags.0.commit_id", "lakefs_tag.release", "commit_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBranchesAndTagsDataSourcesConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_branch" "a" {
+  repository = lakefs_repository.test.id
+  name       = "feature-a"
+  source     = "main"
+}
+
+resource "lakefs_branch" "b" {
+  repository = lakefs_repository.test.id
+  name       = "feature-b"
+  source     = "main"
+}
+
+resource "lakefs_tag" "release" {
+  repository = lakefs_repository.test.id
+  id         = "v1.0.0"
+  ref        = "main"
+}
+
+data "lakefs_branches" "features" {
+  repository = lakefs_repository.test.id
+  prefix     = "feature-"
+
+  depends_on = [lakefs_branch.a, lakefs_branch.b]
+}
+
+data "lakefs_branches" "limited" {
+  repository = lakefs_repository.test.id
+  max_items  = 1
+
+  depends_on = [lakefs_branch.a, lakefs_branch.b]
+}
+
+data "lakefs_tags" "releases" {
+  repository = lakefs_repository.test.id
+  prefix     = "v"
+
+  depends_on = [lakefs_tag.release]
+}
+`, repoName)
+}
+
+// =====================
+// Commit Data Source Tests
+// =====================
+
+func TestAccCommitDataSource(t *testing.T) {
+	repoName := fmt.Sprintf("dscommit%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCommitDataSourceConfig(repoName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lakefs_commit.test", "id"),
+					resource.TestCheckResourceAttrSet("data.lakefs_commit.test", "creation_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCommitDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+data "lakefs_branch" "main" {
+  repository = lakefs_repository.test.id
+  branch     = "main"
+}
+
+data "lakefs_commit" "test" {
+  repository = lakefs_repository.test.id
+  commit_id  = data.lakefs_branch.main.commit_id
+}
+`, repoName)
+}
+
+// =====================
+// Object Data Source Tests
+// =====================
+
+func TestAccObjectDataSources(t *testing.T) {
+	repoName := fmt.Sprintf("dsobjrepo%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourcesConfig(repoName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_object.manifest", "content", `{"version":1}`),
+					resource.TestCheckResourceAttr("data.lakefs_object.manifest", "size_bytes", "13"),
+					resource.TestCheckResourceAttr("data.lakefs_object.manifest", "content_type", "application/json"),
+					resource.TestCheckResourceAttrPair("data.lakefs_object.manifest", "checksum", "lakefs_object.manifest", "checksum"),
+					resource.TestCheckResourceAttrSet("data.lakefs_object.manifest", "physical_address"),
+					resource.TestCheckResourceAttr("data.lakefs_objects.root", "objects.#", "2"),
+					resource.TestCheckResourceAttr("data.lakefs_objects.root", "objects.0.path", "data/"),
+					resource.TestCheckResourceAttr("data.lakefs_objects.root", "objects.0.path_type", "common_prefix"),
+					resource.TestCheckResourceAttr("data.lakefs_objects.root", "objects.1.path", "manifest.json"),
+					resource.TestCheckResourceAttr("data.lakefs_objects.root", "objects.1.path_type", "object"),
+				),
+			},
+		},
+	})
+}
+
+func testAccObjectDataSourcesConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_object" "manifest" {
+  repository   = lakefs_repository.test.id
+  branch       = "main"
+  path         = "manifest.json"
+  content      = jsonencode({ version = 1 })
+  content_type = "application/json"
+}
+
+resource "lakefs_object" "data" {
+  repository = lakefs_repository.test.id
+  branch     = "main"
+  path       = "data/part-0000.csv"
+  content    = "id\n1\n"
+}
+
+data "lakefs_object" "manifest" {
+  repository      = lakefs_repository.test.id
+  ref             = lakefs_object.manifest.branch
+  path            = lakefs_object.manifest.path
+  include_content = true
+}
+
+data "lakefs_objects" "root" {
+  repository = lakefs_repository.test.id
+  ref        = "main"
+  delimiter  = "/"
+
+  depends_on = [lakefs_object.manifest, lakefs_object.data]
+}
+`, repoName)
+}
+
+// =====================
+// User Resource Tests
+// =====================
+
+func TestAccUserResource(t *testing.T) {
+	userID := fmt.Sprintf("testuser%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEnterprise(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResourceConfig(userID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_user.test", "id", userID),
+					resource.TestCheckResourceAttrSet("lakefs_user.test", "creation_date"),
+				),
+			},
+			{
+				ResourceName:      "lakefs_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccUserResourceConfig(userID string) string {
+	return fmt.Sprintf(`
+resource "lakefs_user" "test" {
+  id = %[1]q
+}
+`, userID)
+}
+
+// =====================
+// Group Resource Tests
+// =====================
+
+func TestAccGroupResource(t *testing.T) {
+	groupID := fmt.Sprintf("testgroup%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEnterprise(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupResourceConfig(groupID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_group.test", "id", groupID),
+					resource.TestCheckResourceAttrSet("lakefs_group.test", "creation_date"),
+				),
+			},
+			{
+				ResourceName:      "lakefs_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGroupResourceConfig(groupID string) string {
+	return fmt.Sprintf(`
+resource "lakefs_group" "test" {
+  id = %[1]q
+}
+`, groupID)
+}
+
+// =====================
+// Group Membership Resource Tests
+// =====================
+
+func TestAccGroupMembershipResource(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	groupID := fmt.Sprintf("membergroup%d", suffix)
+	userA := fmt.Sprintf("membera%d", suffix)
+	userB := fmt.Sprintf("memberb%d", suffix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEnterprise(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipResourceConfig(groupID, userA, userB, "lakefs_user.a.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_group_membership.test", "id", groupID),
+					resource.TestCheckResourceAttr("lakefs_group_membership.test", "users.#", "1"),
+				),
+			},
+			{
+				Config: testAccGroupMembershipResourceConfig(groupID, userA, userB, "lakefs_user.a.id, lakefs_user.b.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_group_membership.test", "users.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "lakefs_group_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "lakefs_group_membership.test",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s/%s", groupID, userA),
+				ExpectError:   regexp.MustCompile(`Invalid Import ID`),
+			},
+		},
+	})
+}
+
+func testAccGroupMembershipResourceConfig(groupID, userA, userB, members string) string {
+	return fmt.Sprintf(`
+resource "lakefs_group" "test" {
+  id = %[1]q
+}
+
+resource "lakefs_user" "a" {
+  id = %[2]q
+}
+
+resource "lakefs_user" "b" {
+  id = %[3]q
+}
+
+resource "lakefs_group_membership" "test" {
+  group = lakefs_group.test.id
+  users = [%[4]s]
+}
+`, groupID, userA, userB, members)
+}
+
+// =====================
+// Policy Resource Tests
+// =====================
+
+func TestAccPolicyResource(t *testing.T) {
+	policyID := fmt.Sprintf("testpolicy%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEnterprise(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyResourceConfig(policyID, `"fs:ReadObject"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_policy.test", "id", policyID),
+					resource.TestCheckResourceAttr("lakefs_policy.test", "statements.0.action.#", "1"),
+				),
+			},
+			// Update in place
+			{
+				Config: testAccPolicyResourceConfig(policyID, `"fs:ReadObject", "fs:ListObjects"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_policy.test", "statements.0.action.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "lakefs_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPolicyResourceConfig(policyID, actions string) string {
+	return fmt.Sprintf(`
+resource "lakefs_policy" "test" {
+  id = %[1]q
+
+  statements = [
+    {
+      effect   = "allow"
+      action   = [%[2]s]
+      resource = "arn:lakefs:fs:::repository/*"
+    }
+  ]
+}
+`, policyID, actions)
+}
+
+// =====================
+// Policy Attachment Resource Tests
+// =====================
+
+func TestAccPolicyAttachmentResources(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	userID := fmt.Sprintf("attachuser%d", suffix)
+	groupID := fmt.Sprintf("attachgroup%d", suffix)
+	policyID := fmt.Sprintf("attachpolicy%d", suffix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEnterprise(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyAttachmentResourcesConfig(userID, groupID, policyID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_user_policy_attachment.test", "id", fmt.Sprintf("%s/%s", userID, policyID)),
+					resource.TestCheckResourceAttr("lakefs_group_policy_attachment.test", "id", fmt.Sprintf("%s/%s", groupID, policyID)),
+				),
+			},
+			{
+				ResourceName:      "lakefs_user_policy_attachment.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", userID, policyID),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "lakefs_group_policy_attachment.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", groupID, policyID),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPolicyAttachmentResourcesConfig(userID, groupID, policyID string) string {
+	return fmt.Sprintf(`
+resource "lakefs_user" "test" {
+  id = %[1]q
+}
+
+resource "lakefs_group" "test" {
+  id = %[2]q
+}
+
+resource "lakefs_policy" "test" {
+  id = %[3]q
+
+  statements = [
+    {
+      effect   = "allow"
+      action   = ["fs:ReadObject"]
+      resource = "arn:lakefs:fs:::repository/*"
+    }
+  ]
+}
+
+resource "lakefs_user_policy_attachment" "test" {
+  user   = lakefs_user.test.id
+  policy = lakefs_policy.test.id
+}
+
+resource "lakefs_group_policy_attachment" "test" {
+  group  = lakefs_group.test.id
+  policy = lakefs_policy.test.id
+}
+`, userID, groupID, policyID)
+}
+
+// =====================
+// Credentials Resource Tests
+// =====================
+
+func TestAccCredentialsResource(t *testing.T) {
+	userID := fmt.Sprintf("creduser%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEnterprise(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCredentialsResourceConfig(userID, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_credentials.test", "user", userID),
+					resource.TestCheckResourceAttrSet("lakefs_credentials.test", "access_key_id"),
+					resource.TestCheckResourceAttrSet("lakefs_credentials.test", "secret_access_key"),
+				),
+			},
+			// Rotation replaces the key
+			{
+				Config: testAccCredentialsResourceConfig(userID, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakefs_credentials.test", "rotation_trigger.version", "2"),
+					resource.TestCheckResourceAttrSet("lakefs_credentials.test", "access_key_id"),
+				),
+			},
+			{
+				ResourceName:      "lakefs_credentials.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The secret and rotation trigger cannot be read back from the API
+				ImportStateVerifyIgnore: []string{"secret_access_key", "rotation_trigger"},
+			},
+		},
+	})
+}
+
+func testAccCredentialsResourceConfig(userID, version string) string {
+	return fmt.Sprintf(`
+resource "lakefs_user" "test" {
+  id = %[1]q
+}
+
+resource "lakefs_credentials" "test" {
+  user = lakefs_user.test.id
+
+  rotation_trigger = {
+    version = %[2]q
+  }
+}
+`, userID, version)
+}
+
+// =====================
+// Login Token Ephemeral Resource Tests
+// =====================
+
+func TestAccLoginTokenEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Ephemeral resources are only available in Terraform 1.10 and later
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoginTokenEphemeralResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.test", "data.token"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.token_expiration"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLoginTokenEphemeralResourceConfig = `
+ephemeral "lakefs_login_token" "test" {}
+
+provider "echo" {
+  data = ephemeral.lakefs_login_token.test
+}
+
+resource "echo" "test" {}
+`
+
+// =====================
+// Presigned URL Tests
+// =====================
+
+func TestAccPresignedURL(t *testing.T) {
+	repoName := fmt.Sprintf("presignrepo%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckPresign(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Ephemeral resources are only available in Terraform 1.10 and later
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresignedURLConfig(repoName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lakefs_presigned_url.test", "url"),
+					resource.TestCheckResourceAttrSet("data.lakefs_presigned_url.test", "expiry"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.url"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.expiry"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPresignedURLConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_object" "test" {
+  repository = lakefs_repository.test.id
+  branch     = "main"
+  path       = "exports/report.csv"
+  content    = "id\n1\n"
+}
+
+data "lakefs_presigned_url" "test" {
+  repository = lakefs_object.test.repository
+  ref        = lakefs_object.test.branch
+  path       = lakefs_object.test.path
+}
+
+ephemeral "lakefs_presigned_url" "test" {
+  repository = lakefs_object.test.repository
+  ref        = lakefs_object.test.branch
+  path       = lakefs_object.test.path
+}
+
+provider "echo" {
+  data = ephemeral.lakefs_presigned_url.test
+}
+
+resource "echo" "test" {}
+`, repoName)
+}
+
+// =====================
+// Action Run Data Source Tests
+// =====================
+
+func TestAccActionRunDataSources(t *testing.T) {
+	repoName := fmt.Sprintf("runsrepo%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActionRunDataSourcesConfig(repoName, ""),
+			},
+			// A commit blocked by a failing hook reports the run ID
+			{
+				Config: testAccActionRunDataSourcesConfig(repoName, `
+resource "lakefs_commit" "blocked" {
+  repository  = lakefs_repository.test.id
+  branch      = lakefs_branch.guarded.name
+  message     = "Blocked by hook"
+  allow_empty = true
+}
+`),
+				ExpectError: regexp.MustCompile(`rejected by action run`),
+			},
+			{
+				Config: testAccActionRunDataSourcesConfig(repoName, `
+data "lakefs_action_runs" "guarded" {
+  repository = lakefs_repository.test.id
+  branch     = lakefs_branch.guarded.name
+}
+
+data "lakefs_action_run" "blocked" {
+  repository = lakefs_repository.test.id
+  run_id     = data.lakefs_action_runs.guarded.runs[0].run_id
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_action_runs.guarded", "runs.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_action_runs.guarded", "runs.0.event_type", "pre-commit"),
+					resource.TestCheckResourceAttr("data.lakefs_action_runs.guarded", "runs.0.status", "failed"),
+					resource.TestCheckResourceAttr("data.lakefs_action_run.blocked", "status", "failed"),
+					resource.TestCheckResourceAttr("data.lakefs_action_run.blocked", "hooks.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_action_run.blocked", "hooks.0.hook_id", "always_fail"),
+					resource.TestCheckResourceAttr("data.lakefs_action_run.blocked", "hooks.0.status", "failed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccActionRunDataSourcesConfig(repoName, extra string) string {
+	return fmt.Sprintf(`
+resource "lakefs_repository" "test" {
+  name              = %[1]q
+  storage_namespace = "s3://lakefs-data/%[1]s"
+  default_branch    = "main"
+}
+
+resource "lakefs_action" "block" {
+  repository = lakefs_repository.test.id
+  branch     = "main"
+  name       = "block guarded commits"
+
+  on = {
+    "pre-commit" = {
+      branches = ["guarded"]
+    }
+  }
+
+  hooks = [
+    {
+      id   = "always_fail"
+      type = "lua"
+      properties = {
+        script = "error(\"commits to guarded are blocked\")"
+      }
+    }
+  ]
+}
+
+resource "lakefs_branch" "guarded" {
+  repository = lakefs_repository.test.id
+  name       = "guarded"
+  source     = lakefs_action.block.commit_id
+}
+%[2]s`, repoName, extra)
+}
+
+// =====================
+// Users, Groups and Policies Data Source Tests
+// =====================
+
+func TestAccAuthListDataSources(t *testing.T) {
+	prefix := fmt.Sprintf("dsauth%d", time.Now().UnixNano())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEnterprise(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthListDataSourcesConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakefs_users.test", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.lakefs_groups.test", "groups.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_groups.test", "groups.0.id", prefix+"-group"),
+					resource.TestCheckResourceAttr("data.lakefs_policies.test", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.lakefs_policies.test", "policies.0.statements.0.effect", "allow"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuthListDataSourcesConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "lakefs_user" "a" {
+  id = "%[1]s-a"
+}
+
+resource "lakefs_user" "b" {
+  id = "%[1]s-b"
+}
+
+resource "lakefs_group" "test" {
+  id = "%[1]s-group"
+}
+
+resource "lakefs_policy" "test" {
+  id = "%[1]s-policy"
+
+  statements = [
+    {
+      effect   = "allow"
+      action   = ["fs:ReadObject"]
+      resource = "arn:lakefs:fs:::repository/*"
+    }
+  ]
+}
+
+data "lakefs_users" "test" {
+  prefix     = %[1]q
+  depends_on = [lakefs_user.a, lakefs_user.b]
+}
+
+data "lakefs_groups" "test" {
+  prefix     = %[1]q
+  depends_on = [lakefs_group.test]
+}
+
+data "lakefs_policies" "test" {
+  prefix     = %[1]q
+  depends_on = [lakefs_policy.test]
+}
+`, prefix)
 }
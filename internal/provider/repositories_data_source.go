@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RepositoriesDataSource{}
+
+func NewRepositoriesDataSource() datasource.DataSource {
+	return &RepositoriesDataSource{}
+}
+
+// RepositoriesDataSource defines the data source implementation.
+type RepositoriesDataSource struct {
+	client *APIClient
+}
+
+// RepositoriesModel describes the data source data model.
+type RepositoriesModel struct {
+	Prefix       types.String          `tfsdk:"prefix"`
+	Search       types.String          `tfsdk:"search"`
+	Repositories []RepositoryItemModel `tfsdk:"repositories"`
+}
+
+// RepositoryItemModel describes a single repository in the list.
+type RepositoryItemModel struct {
+	Id               types.String `tfsdk:"id"`
+	StorageNamespace types.String `tfsdk:"storage_namespace"`
+	DefaultBranch    types.String `tfsdk:"default_branch"`
+	CreationDate     types.Int64  `tfsdk:"creation_date"`
+	ReadOnly         types.Bool   `tfsdk:"read_only"`
+}
+
+func (d *RepositoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repositories"
+}
+
+func (d *RepositoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists LakeFS repositories.",
+		MarkdownDescription: `Lists LakeFS repositories.
+
+## Example Usage
+
+` + "```hcl" + `
+data "lakefs_repositories" "analytics" {
+  prefix = "analytics-"
+}
+
+resource "lakefs_branch_protection" "main" {
+  for_each = { for repo in data.lakefs_repositories.analytics.repositories : repo.id => repo }
+
+  repository = each.key
+
+  rules = [
+    { pattern = each.value.default_branch }
+  ]
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return repositories whose ID starts with this prefix.",
+			},
+			"search": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return repositories whose ID contains this string.",
+			},
+			"repositories": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The repositories matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The repository identifier.",
+						},
+						"storage_namespace": schema.StringAttribute{
+							Computed:    true,
+							Description: "The storage namespace of the repository.",
+						},
+						"default_branch": schema.StringAttribute{
+							Computed:    true,
+							Description: "The default branch of the repository.",
+						},
+						"creation_date": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Unix epoch timestamp when the repository was created.",
+						},
+						"read_only": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the repository is read-only.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RepositoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RepositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RepositoriesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if prefix := data.Prefix.ValueString(); prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if search := data.Search.ValueString(); search != "" {
+		query.Set("search", search)
+	}
+
+	data.Repositories = []RepositoryItemModel{}
+	for repo, err := range Paginate[RepositoryResponse](ctx, d.client, "/repositories", ListOptions{Query: query}) {
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list repositories: %s", err))
+			return
+		}
+
+		data.Repositories = append(data.Repositories, RepositoryItemModel{
+			Id:               types.StringValue(repo.ID),
+			StorageNamespace: types.StringValue(repo.StorageNamespace),
+			DefaultBranch:    types.StringValue(repo.DefaultBranch),
+			CreationDate:     types.Int64Value(repo.CreationDate),
+			ReadOnly:         types.BoolValue(repo.ReadOnly),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
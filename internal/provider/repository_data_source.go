@@ -22,7 +22,7 @@ func NewRepositoryDataSource() datasource.DataSource {
 
 // RepositoryDataSource defines the data source implementation.
 type RepositoryDataSource struct {
-	client *LakeFSClient
+	client *APIClient
 }
 
 func (d *RepositoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,11 +38,11 @@ func (d *RepositoryDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
+	client, ok := req.ProviderData.(*APIClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -58,12 +58,10 @@ func (d *RepositoryDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	client := NewAPIClient(d.client)
-
 	repoID := data.Repository.ValueString()
 
 	var result RepositoryResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s", repoID), &result)
+	err := d.client.Get(ctx, fmt.Sprintf("/repositories/%s", repoID), &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository: %s", err))
 		return
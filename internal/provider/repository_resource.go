@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -18,13 +20,26 @@ import (
 var _ resource.Resource = &RepositoryResource{}
 var _ resource.ResourceWithImportState = &RepositoryResource{}
 
+// repositoryTimeouts has no update timeout, since repositories are never updated in LakeFS.
+var repositoryTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Delete: true,
+}
+
 func NewRepositoryResource() resource.Resource {
 	return &RepositoryResource{}
 }
 
 // RepositoryResource defines the resource implementation.
 type RepositoryResource struct {
-	client *LakeFSClient
+	client *APIClient
+}
+
+// repositoryResourceModel adds the timeouts block to the generated repository model.
+type repositoryResourceModel struct {
+	resource_repository.RepositoryModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // RepositoryCreateRequest represents the request to create a repository
@@ -53,6 +68,9 @@ func (r *RepositoryResource) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *RepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_repository.RepositoryResourceSchema(ctx)
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": timeouts.Block(ctx, repositoryTimeouts),
+	}
 }
 
 func (r *RepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -61,11 +79,11 @@ func (r *RepositoryResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
+	client, ok := req.ProviderData.(*APIClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -74,14 +92,22 @@ func (r *RepositoryResource) Configure(ctx context.Context, req resource.Configu
 }
 
 func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_repository.RepositoryModel
+	var data repositoryResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
 
 	createReq := RepositoryCreateRequest{
 		Name:             data.Name.ValueString(),
@@ -106,8 +132,12 @@ func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequ
 	})
 
 	var result RepositoryResponse
-	err := client.Post(ctx, "/repositories", createReq, &result)
+	err := r.client.Post(ctx, "/repositories", createReq, &result)
 	if err != nil {
+		if IsConflict(err) {
+			resp.Diagnostics.AddError("Repository Already Exists", alreadyExistsDetail("repository", createReq.Name, "lakefs_repository", createReq.Name, err))
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create repository: %s", err))
 		return
 	}
@@ -128,14 +158,22 @@ func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_repository.RepositoryModel
+	var data repositoryResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
 
 	repoID := data.Id.ValueString()
 	if repoID == "" {
@@ -143,7 +181,7 @@ func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	var result RepositoryResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s", repoID), &result)
+	err := r.client.Get(ctx, fmt.Sprintf("/repositories/%s", repoID), &result)
 	if err != nil {
 		if IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -167,27 +205,53 @@ func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *RepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_repository.RepositoryModel
+	var data, state repositoryResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// LakeFS repositories are immutable - most attributes cannot be updated
 	// We just save the state as-is
+	// Computed values are planned as unknown on any in-place change, such as to timeouts, so keep them from state
+	if data.CreationDate.IsUnknown() {
+		data.CreationDate = state.CreationDate
+	}
+	if data.DefaultBranch.IsUnknown() {
+		data.DefaultBranch = state.DefaultBranch
+	}
+	if data.Id.IsUnknown() {
+		data.Id = state.Id
+	}
+	if data.Repository.IsUnknown() {
+		data.Repository = state.Repository
+	}
+	if data.StorageId.IsUnknown() {
+		data.StorageId = state.StorageId
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *RepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_repository.RepositoryModel
+	var data repositoryResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
 
 	repoID := data.Id.ValueString()
 	if repoID == "" {
@@ -196,7 +260,7 @@ func (r *RepositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	tflog.Debug(ctx, "Deleting repository", map[string]any{"id": repoID})
 
-	err := client.Delete(ctx, fmt.Sprintf("/repositories/%s", repoID))
+	err := r.client.Delete(ctx, fmt.Sprintf("/repositories/%s", repoID))
 	if err != nil {
 		if !IsNotFound(err) {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete repository: %s", err))
@@ -208,16 +272,15 @@ func (r *RepositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *RepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	client := NewAPIClient(r.client)
-
 	var result RepositoryResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s", req.ID), &result)
+	err := r.client.Get(ctx, fmt.Sprintf("/repositories/%s", req.ID), &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import repository %s: %s", req.ID, err))
 		return
 	}
 
-	var data resource_repository.RepositoryModel
+	var data repositoryResourceModel
+	data.Timeouts = nullTimeouts(ctx, repositoryTimeouts)
 	data.Id = types.StringValue(result.ID)
 	data.Repository = types.StringValue(result.ID)
 	data.Name = types.StringValue(result.ID)
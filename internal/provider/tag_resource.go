@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -19,13 +21,26 @@ import (
 var _ resource.Resource = &TagResource{}
 var _ resource.ResourceWithImportState = &TagResource{}
 
+// tagTimeouts has no update timeout, since tags are immutable.
+var tagTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Delete: true,
+}
+
 func NewTagResource() resource.Resource {
 	return &TagResource{}
 }
 
 // TagResource defines the resource implementation.
 type TagResource struct {
-	client *LakeFSClient
+	client *APIClient
+}
+
+// tagResourceModel adds the timeouts block to the generated tag model.
+type tagResourceModel struct {
+	resource_tag.TagModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // TagCreateRequest represents the request to create a tag
@@ -46,6 +61,9 @@ func (r *TagResource) Metadata(ctx context.Context, req resource.MetadataRequest
 
 func (r *TagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_tag.TagResourceSchema(ctx)
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": timeouts.Block(ctx, tagTimeouts),
+	}
 }
 
 func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,11 +71,11 @@ func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureReque
 		return
 	}
 
-	client, ok := req.ProviderData.(*LakeFSClient)
+	client, ok := req.ProviderData.(*APIClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *LakeFSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -66,14 +84,22 @@ func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureReque
 }
 
 func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_tag.TagModel
+	var data tagResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
 
 	repository := data.Repository.ValueString()
 	// In the generated schema, Id is the tag name (required field)
@@ -91,8 +117,12 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	})
 
 	var result TagResponse
-	err := client.Post(ctx, fmt.Sprintf("/repositories/%s/tags", repository), createReq, &result)
+	err := r.client.Post(ctx, fmt.Sprintf("/repositories/%s/tags", repository), createReq, &result)
 	if err != nil {
+		if IsConflict(err) {
+			resp.Diagnostics.AddError("Tag Already Exists", alreadyExistsDetail("tag", createReq.ID, "lakefs_tag", fmt.Sprintf("%s/%s", repository, createReq.ID), err))
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tag: %s", err))
 		return
 	}
@@ -110,14 +140,22 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 }
 
 func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_tag.TagModel
+	var data tagResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
 
 	repository := data.Repository.ValueString()
 	// Id is the tag name in the generated schema
@@ -127,7 +165,7 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	var result TagResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/tags/%s", repository, tagName), &result)
+	err := r.client.Get(ctx, fmt.Sprintf("/repositories/%s/tags/%s", repository, tagName), &result)
 	if err != nil {
 		if IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -144,26 +182,46 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 }
 
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_tag.TagModel
+	var data, state tagResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Tags in LakeFS are immutable
+	// Computed values are planned as unknown on any in-place change, such as to timeouts, so keep them from state
+	if data.CommitId.IsUnknown() {
+		data.CommitId = state.CommitId
+	}
+	if data.Repository.IsUnknown() {
+		data.Repository = state.Repository
+	}
+	if data.Tag.IsUnknown() {
+		data.Tag = state.Tag
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_tag.TagModel
+	var data tagResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client := NewAPIClient(r.client)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
 
 	repository := data.Repository.ValueString()
 	// Id is the tag name in the generated schema
@@ -177,7 +235,7 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		"tag":        tagName,
 	})
 
-	err := client.Delete(ctx, fmt.Sprintf("/repositories/%s/tags/%s", repository, tagName))
+	err := r.client.Delete(ctx, fmt.Sprintf("/repositories/%s/tags/%s", repository, tagName))
 	if err != nil {
 		if !IsNotFound(err) {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tag: %s", err))
@@ -202,18 +260,18 @@ func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateR
 		return
 	}
 
-	client := NewAPIClient(r.client)
 	repository := parts[0]
 	tagName := parts[1]
 
 	var result TagResponse
-	err := client.Get(ctx, fmt.Sprintf("/repositories/%s/tags/%s", repository, tagName), &result)
+	err := r.client.Get(ctx, fmt.Sprintf("/repositories/%s/tags/%s", repository, tagName), &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import tag %s: %s", req.ID, err))
 		return
 	}
 
-	var data resource_tag.TagModel
+	var data tagResourceModel
+	data.Timeouts = nullTimeouts(ctx, tagTimeouts)
 	data.Id = types.StringValue(tagName) // Id is the tag name
 	data.Repository = types.StringValue(repository)
 	data.Tag = types.StringValue(tagName)
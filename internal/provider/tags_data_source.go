@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TagsDataSource{}
+
+func NewTagsDataSource() datasource.DataSource {
+	return &TagsDataSource{}
+}
+
+// TagsDataSource defines the data source implementation.
+type TagsDataSource struct {
+	client *APIClient
+}
+
+// TagsModel describes the data source data model.
+type TagsModel struct {
+	Repository types.String   `tfsdk:"repository"`
+	Prefix     types.String   `tfsdk:"prefix"`
+	MaxItems   types.Int64    `tfsdk:"max_items"`
+	Tags       []TagItemModel `tfsdk:"tags"`
+}
+
+// TagItemModel describes a single tag in the list.
+type TagItemModel struct {
+	Id       types.String `tfsdk:"id"`
+	CommitId types.String `tfsdk:"commit_id"`
+}
+
+func (d *TagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *TagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the tags of a LakeFS repository.",
+		MarkdownDescription: `Lists the tags of a LakeFS repository.
+
+## Example Usage
+
+` + "```hcl" + `
+data "lakefs_tags" "releases" {
+  repository = lakefs_repository.example.id
+  prefix     = "v"
+}
+
+output "release_commits" {
+  value = { for tag in data.lakefs_tags.releases.tags : tag.id => tag.commit_id }
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "The repository to list tags from.",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return tags whose name starts with this prefix.",
+			},
+			"max_items": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Stop after this many tags. By default all pages are fetched.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"tags": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The tags matching the filter, in lexicographical order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the tag.",
+						},
+						"commit_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The commit ID the tag points to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TagsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if prefix := data.Prefix.ValueString(); prefix != "" {
+		query.Set("prefix", prefix)
+	}
+
+	opts := ListOptions{
+		Query:    query,
+		MaxItems: int(data.MaxItems.ValueInt64()),
+	}
+	path := fmt.Sprintf("/repositories/%s/tags", data.Repository.ValueString())
+
+	data.Tags = []TagItemModel{}
+	for tag, err := range Paginate[TagResponse](ctx, d.client, path, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list tags: %s", err))
+			return
+		}
+
+		data.Tags = append(data.Tags, TagItemModel{
+			Id:       types.StringValue(tag.ID),
+			CommitId: types.StringValue(tag.CommitID),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
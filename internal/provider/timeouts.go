@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Operation timeouts used when a resource's timeouts block does not set one. Each request within
+// an operation is additionally bounded by the provider's request_timeout.
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
+)
+
+// nullTimeouts returns an unset timeouts block with the attributes enabled by opts, for state that
+// is built from the API rather than from a plan, such as on import. opts must be the same options
+// the resource passes to timeouts.Block.
+func nullTimeouts(ctx context.Context, opts timeouts.Opts) timeouts.Value {
+	attrTypes := timeouts.Block(ctx, opts).Type().(attr.TypeWithAttributeTypes).AttributeTypes()
+
+	return timeouts.Value{
+		Object: types.ObjectNull(attrTypes),
+	}
+}
+
+// reportTimeout adds an "Operation Timed Out" diagnostic when the operation failed because ctx,
+// created with the operation's timeout, expired. Defer it right after the context is created.
+func reportTimeout(ctx context.Context, operation string, timeout time.Duration, diags *diag.Diagnostics) {
+	if !diags.HasError() || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return
+	}
+
+	diags.AddError(
+		"Operation Timed Out",
+		fmt.Sprintf("The %s operation did not finish within its timeout of %s. "+
+			"If the operation needs more time, set timeouts { %s = \"...\" } in the resource configuration.", operation, timeout, operation),
+	)
+}
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserPolicyAttachmentResource{}
+var _ resource.ResourceWithImportState = &UserPolicyAttachmentResource{}
+
+// userPolicyAttachmentTimeouts has no update timeout, since every argument forces replacement.
+var userPolicyAttachmentTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Delete: true,
+}
+
+func NewUserPolicyAttachmentResource() resource.Resource {
+	return &UserPolicyAttachmentResource{}
+}
+
+// UserPolicyAttachmentResource defines the resource implementation.
+type UserPolicyAttachmentResource struct {
+	client *APIClient
+}
+
+// UserPolicyAttachmentModel describes the resource data model.
+type UserPolicyAttachmentModel struct {
+	Id       types.String   `tfsdk:"id"`
+	User     types.String   `tfsdk:"user"`
+	Policy   types.String   `tfsdk:"policy"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *UserPolicyAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_policy_attachment"
+}
+
+func (r *UserPolicyAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a LakeFS policy to a user.",
+		MarkdownDescription: `Attaches a LakeFS policy to a user.
+
+This resource requires LakeFS Enterprise or LakeFS Cloud.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "lakefs_user_policy_attachment" "engineer_read" {
+  user   = lakefs_user.engineer.id
+  policy = lakefs_policy.read_example.id
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for this resource, in the format 'user/policy'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Required:    true,
+				Description: "The user ID to attach the policy to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Required:    true,
+				Description: "The policy ID to attach.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, userPolicyAttachmentTimeouts),
+		},
+	}
+}
+
+func (r *UserPolicyAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserPolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserPolicyAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
+	user := data.User.ValueString()
+	policy := data.Policy.ValueString()
+
+	tflog.Debug(ctx, "Attaching policy to user", map[string]any{
+		"user":   user,
+		"policy": policy,
+	})
+
+	err := r.client.Put(ctx, fmt.Sprintf("/auth/users/%s/policies/%s", user, policy), nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to attach policy to user: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", user, policy))
+
+	tflog.Trace(ctx, "Attached policy to user", map[string]any{"id": data.Id.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPolicyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserPolicyAttachmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
+	user := data.User.ValueString()
+	policy := data.Policy.ValueString()
+
+	attached, err := isPolicyAttached(ctx, r.client, fmt.Sprintf("/auth/users/%s/policies", user), policy)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user policies: %s", err))
+		return
+	}
+
+	if !attached {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", user, policy))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserPolicyAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All attributes force replacement, so there is nothing to update in LakeFS
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserPolicyAttachmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
+
+	user := data.User.ValueString()
+	policy := data.Policy.ValueString()
+
+	tflog.Debug(ctx, "Detaching policy from user", map[string]any{
+		"user":   user,
+		"policy": policy,
+	})
+
+	err := r.client.Delete(ctx, fmt.Sprintf("/auth/users/%s/policies/%s", user, policy))
+	if err != nil {
+		if !IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach policy from user: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Detached policy from user", map[string]any{
+		"user":   user,
+		"policy": policy,
+	})
+}
+
+func (r *UserPolicyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: user/policy
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'user/policy', got: %s", req.ID),
+		)
+		return
+	}
+
+	user := parts[0]
+	policy := parts[1]
+
+	attached, err := isPolicyAttached(ctx, r.client, fmt.Sprintf("/auth/users/%s/policies", user), policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import user policy attachment %s: %s", req.ID, err))
+		return
+	}
+	if !attached {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Policy %s is not attached to user %s", policy, user))
+		return
+	}
+
+	var data UserPolicyAttachmentModel
+	data.Timeouts = nullTimeouts(ctx, userPolicyAttachmentTimeouts)
+	data.Id = types.StringValue(req.ID)
+	data.User = types.StringValue(user)
+	data.Policy = types.StringValue(policy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// isPolicyAttached reports whether policy appears in the policy list at path,
+// such as /auth/users/{user}/policies
+func isPolicyAttached(ctx context.Context, client *APIClient, path, policy string) (bool, error) {
+	for p, err := range Paginate[PolicyResponse](ctx, client, path, ListOptions{}) {
+		if err != nil {
+			return false, err
+		}
+		if p.ID == policy {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+
+// userTimeouts has no update timeout, since users are replaced rather than updated.
+var userTimeouts = timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Delete: true,
+}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+// UserResource defines the resource implementation.
+type UserResource struct {
+	client *APIClient
+}
+
+// UserModel describes the resource data model.
+type UserModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Email        types.String   `tfsdk:"email"`
+	FriendlyName types.String   `tfsdk:"friendly_name"`
+	InviteUser   types.Bool     `tfsdk:"invite_user"`
+	CreationDate types.Int64    `tfsdk:"creation_date"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// UserCreateRequest represents the request to create a user
+type UserCreateRequest struct {
+	ID           string `json:"id"`
+	Email        string `json:"email,omitempty"`
+	FriendlyName string `json:"friendly_name,omitempty"`
+	InviteUser   bool   `json:"invite_user,omitempty"`
+}
+
+// UserResponse represents the API response for a user
+type UserResponse struct {
+	ID           string `json:"id"`
+	Email        string `json:"email,omitempty"`
+	FriendlyName string `json:"friendly_name,omitempty"`
+	CreationDate int64  `json:"creation_date"`
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a LakeFS user.",
+		MarkdownDescription: `Manages a LakeFS user.
+
+Users can only be created or deleted; changing any attribute replaces the user. This resource requires LakeFS Enterprise or LakeFS Cloud.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "lakefs_user" "engineer" {
+  id            = "jane.doe"
+  email         = "jane.doe@example.com"
+  friendly_name = "Jane Doe"
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "A unique identifier for the user. When invite_user is true this must be the user's email address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The email address of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"friendly_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "A shorter, more friendly name for the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invite_user": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Send an invitation email to the user on creation. Default is false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"creation_date": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Unix epoch timestamp when the user was created.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, userTimeouts),
+		},
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "create", createTimeout, &resp.Diagnostics)
+
+	createReq := UserCreateRequest{
+		ID:         data.Id.ValueString(),
+		InviteUser: data.InviteUser.ValueBool(),
+	}
+
+	if !data.Email.IsNull() && !data.Email.IsUnknown() {
+		createReq.Email = data.Email.ValueString()
+	}
+
+	if !data.FriendlyName.IsNull() && !data.FriendlyName.IsUnknown() {
+		createReq.FriendlyName = data.FriendlyName.ValueString()
+	}
+
+	tflog.Debug(ctx, "Creating user", map[string]any{
+		"id":     createReq.ID,
+		"invite": createReq.InviteUser,
+	})
+
+	var result UserResponse
+	err := r.client.Post(ctx, "/auth/users", createReq, &result)
+	if err != nil {
+		if IsConflict(err) {
+			resp.Diagnostics.AddError("User Already Exists", alreadyExistsDetail("user", createReq.ID, "lakefs_user", createReq.ID, err))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user: %s", err))
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(result.ID)
+	data.Email = types.StringValue(result.Email)
+	data.FriendlyName = types.StringValue(result.FriendlyName)
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	tflog.Trace(ctx, "Created user", map[string]any{"id": result.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "read", readTimeout, &resp.Diagnostics)
+
+	var result UserResponse
+	err := r.client.Get(ctx, fmt.Sprintf("/auth/users/%s", data.Id.ValueString()), &result)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user: %s", err))
+		return
+	}
+
+	// Map response to state
+	data.Id = types.StringValue(result.ID)
+	data.Email = types.StringValue(result.Email)
+	data.FriendlyName = types.StringValue(result.FriendlyName)
+	data.CreationDate = types.Int64Value(result.CreationDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All user attributes force replacement, so there is nothing to update in LakeFS
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	defer reportTimeout(ctx, "delete", deleteTimeout, &resp.Diagnostics)
+
+	userID := data.Id.ValueString()
+
+	tflog.Debug(ctx, "Deleting user", map[string]any{"id": userID})
+
+	err := r.client.Delete(ctx, fmt.Sprintf("/auth/users/%s", userID))
+	if err != nil {
+		if !IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted user", map[string]any{"id": userID})
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var result UserResponse
+	err := r.client.Get(ctx, fmt.Sprintf("/auth/users/%s", req.ID), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import user %s: %s", req.ID, err))
+		return
+	}
+
+	var data UserModel
+	data.Timeouts = nullTimeouts(ctx, userTimeouts)
+	data.Id = types.StringValue(result.ID)
+	data.Email = types.StringValue(result.Email)
+	data.FriendlyName = types.StringValue(result.FriendlyName)
+	data.CreationDate = types.Int64Value(result.CreationDate)
+	data.InviteUser = types.BoolValue(false) // The invite flag is not returned by the API
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
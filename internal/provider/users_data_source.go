@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client *APIClient
+}
+
+// UsersModel describes the data source data model.
+type UsersModel struct {
+	Prefix types.String    `tfsdk:"prefix"`
+	Users  []UserItemModel `tfsdk:"users"`
+}
+
+// UserItemModel describes a single user in the list.
+type UserItemModel struct {
+	Id           types.String `tfsdk:"id"`
+	Email        types.String `tfsdk:"email"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	CreationDate types.Int64  `tfsdk:"creation_date"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists LakeFS users.",
+		MarkdownDescription: `Lists LakeFS users.
+
+This data source requires LakeFS Enterprise or LakeFS Cloud.
+
+## Example Usage
+
+` + "```hcl" + `
+data "lakefs_users" "service_accounts" {
+  prefix = "svc-"
+}
+
+output "service_account_ids" {
+  value = data.lakefs_users.service_accounts.users[*].id
+}
+` + "```",
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return users whose ID starts with this prefix.",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The users matching the filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the user.",
+						},
+						"email": schema.StringAttribute{
+							Computed:    true,
+							Description: "The email address of the user.",
+						},
+						"friendly_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "A shorter, more friendly name for the user.",
+						},
+						"creation_date": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Unix epoch timestamp when the user was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if prefix := data.Prefix.ValueString(); prefix != "" {
+		query.Set("prefix", prefix)
+	}
+
+	data.Users = []UserItemModel{}
+	for user, err := range Paginate[UserResponse](ctx, d.client, "/auth/users", ListOptions{Query: query}) {
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users: %s", err))
+			return
+		}
+
+		data.Users = append(data.Users, UserItemModel{
+			Id:           types.StringValue(user.ID),
+			Email:        types.StringValue(user.Email),
+			FriendlyName: types.StringValue(user.FriendlyName),
+			CreationDate: types.Int64Value(user.CreationDate),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}